@@ -0,0 +1,32 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/mtt-labs/poly-market-sdk/client"
+)
+
+// testPrivateKey well-known development key (address 0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266)
+const testPrivateKey = "ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80"
+
+// newTestClient creates a client of the test key serving the CLOB, Gamma and Data APIs from baseURL,
+// without time sync or retries. opts adjust the config before the client is created.
+func newTestClient(t *testing.T, baseURL string, opts ...func(*client.Config)) *client.Client {
+	t.Helper()
+	config := &client.Config{
+		BaseURL:      baseURL,
+		GammaBaseURL: baseURL,
+		DataBaseURL:  baseURL,
+		PrivateKey:   testPrivateKey,
+		TimeSync:     &client.TimeSyncConfig{Disabled: true},
+		RetryPolicy:  client.NoRetryPolicy(),
+	}
+	for _, opt := range opts {
+		opt(config)
+	}
+	c, err := client.NewClient(config)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	return c
+}
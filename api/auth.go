@@ -1,11 +1,15 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"log/slog"
 	"math/big"
-	"time"
+	"net/http"
 
+	"github.com/mtt-labs/poly-market-sdk/auth"
 	"github.com/mtt-labs/poly-market-sdk/client"
 )
 
@@ -15,16 +19,16 @@ type AuthAPI struct {
 }
 
 // NewAuthAPI creates a new AuthAPI instance
+// It registers itself as the client's credential refresher, so that the client can create or
+// derive fresh credentials on authentication errors (see client.Config.AutoRefreshCredentials)
 func NewAuthAPI(c *client.Client) *AuthAPI {
-	return &AuthAPI{client: c}
+	a := &AuthAPI{client: c}
+	c.SetCredentialRefresher(a.refreshCredentials)
+	return a
 }
 
 // APICredentials API credentials
-type APICredentials struct {
-	Key        string `json:"key"`
-	Secret     string `json:"secret"`
-	Passphrase string `json:"passphrase"`
-}
+type APICredentials = client.Credentials
 
 // CreateAPICredentialsRequest create API credentials request
 type CreateAPICredentialsRequest struct {
@@ -41,95 +45,360 @@ type CreateAPICredentialsResponse struct {
 	Passphrase string `json:"passphrase"`
 }
 
+// APIKeyResult result of creating or deriving an API key
+type APIKeyResult struct {
+	Credentials *APICredentials // API credentials, set on the client for the default nonce only
+	Address     string          // Address owning the key
+	Nonce       *big.Int        // L1 nonce the key is bound to, each nonce holds a separate key set
+	Derived     bool            // True when an existing key was derived rather than created
+}
+
+// APIKeysResult API keys of the authenticated address
+type APIKeysResult struct {
+	APIKeys []string
+}
+
+// DeleteAPIKeyResult result of deleting an API key
+type DeleteAPIKeyResult struct {
+	Key string // Deleted API key
+}
+
+// ReadOnlyAPIKeyResult read-only API key
+// Read-only keys can query orders and trades of the address, but cannot place or cancel orders
+type ReadOnlyAPIKeyResult struct {
+	Key string `json:"apiKey"`
+}
+
+// ReadOnlyAPIKeysResult read-only API keys of the authenticated address
+type ReadOnlyAPIKeysResult struct {
+	APIKeys []string
+}
+
+// deleteReadOnlyAPIKeyRequest delete read-only API key request
+type deleteReadOnlyAPIKeyRequest struct {
+	Key string `json:"key"`
+}
+
 // CreateOrDeriveAPICredentials creates or derives API credentials
 // Reference: https://docs.polymarket.com/developers/CLOB/authentication
 // If API key already exists, derive it; otherwise create a new one
 func (a *AuthAPI) CreateOrDeriveAPICredentials() (*APICredentials, error) {
-	// First try to derive (if exists)
-	//creds, err := a.DeriveAPICredentials()
-	//if err == nil && creds != nil {
-	//	return creds, nil
-	//}
+	return a.CreateOrDeriveAPICredentialsCtx(context.Background())
+}
 
-	// If derivation fails, create new
-	return a.CreateAPICredentials()
+// CreateOrDeriveAPICredentialsCtx is like CreateOrDeriveAPICredentials but bound to ctx
+func (a *AuthAPI) CreateOrDeriveAPICredentialsCtx(ctx context.Context) (*APICredentials, error) {
+	result, err := a.CreateOrDeriveAPIKeyCtx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return result.Credentials, nil
 }
 
 // CreateAPICredentials creates new API credentials
 // This endpoint requires L1 Header
 // Reference: https://docs.polymarket.com/developers/CLOB/authentication
 func (a *AuthAPI) CreateAPICredentials() (*APICredentials, error) {
-	endpoint := "/auth/api-key"
+	return a.CreateAPICredentialsCtx(context.Background())
+}
 
-	// Generate L1 authentication signature
-	address := a.client.GetAddress()
-	timestamp := time.Now().Unix()
-	nonce := big.NewInt(0)
+// CreateAPICredentialsCtx is like CreateAPICredentials but bound to ctx
+func (a *AuthAPI) CreateAPICredentialsCtx(ctx context.Context) (*APICredentials, error) {
+	result, err := a.CreateAPIKeyCtx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return result.Credentials, nil
+}
 
-	signer := a.client.GetSigner()
-	l1Headers, err := signer.SignL1Auth(address, timestamp, nonce)
+// DeriveAPICredentials derives API credentials
+// This endpoint requires L1 Header
+// Reference: https://docs.polymarket.com/developers/CLOB/authentication
+func (a *AuthAPI) DeriveAPICredentials() (*APICredentials, error) {
+	return a.DeriveAPICredentialsCtx(context.Background())
+}
+
+// DeriveAPICredentialsCtx is like DeriveAPICredentials but bound to ctx
+func (a *AuthAPI) DeriveAPICredentialsCtx(ctx context.Context) (*APICredentials, error) {
+	result, err := a.DeriveAPIKeyCtx(ctx, nil)
 	if err != nil {
-		return nil, fmt.Errorf("sign L1 auth: %w", err)
+		return nil, err
+	}
+	return result.Credentials, nil
+}
+
+// CreateOrDeriveAPIKey creates the API key of a nonce, or derives it when it already exists
+// nil nonce uses the default nonce 0. Only the default nonce key is set on the client and saved in the
+// credential store, the key set of another nonce is returned without changing the client credentials.
+// Reference: https://docs.polymarket.com/developers/CLOB/authentication
+func (a *AuthAPI) CreateOrDeriveAPIKey(nonce *big.Int) (*APIKeyResult, error) {
+	return a.CreateOrDeriveAPIKeyCtx(context.Background(), nonce)
+}
+
+// CreateOrDeriveAPIKeyCtx is like CreateOrDeriveAPIKey but bound to ctx
+func (a *AuthAPI) CreateOrDeriveAPIKeyCtx(ctx context.Context, nonce *big.Int) (*APIKeyResult, error) {
+	result, err := a.CreateAPIKeyCtx(ctx, nonce)
+	if err == nil {
+		return result, nil
+	}
+
+	// Creation fails when a key already exists for the nonce, derive it instead
+	var apiErr *client.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode >= 500 {
+		return nil, err
+	}
+
+	result, deriveErr := a.DeriveAPIKeyCtx(ctx, nonce)
+	if deriveErr != nil {
+		return nil, fmt.Errorf("create or derive API key: %w (create failed: %v)", deriveErr, err)
+	}
+	return result, nil
+}
+
+// CreateAPIKey creates a new API key for a nonce (nil uses the default nonce 0)
+// The default nonce key is set on the client and saved in the credential store, the key of another
+// nonce is only returned, install it with client.SetAPICredentials to trade with it
+// This endpoint requires L1 Header
+// Reference: https://docs.polymarket.com/developers/CLOB/authentication
+func (a *AuthAPI) CreateAPIKey(nonce *big.Int) (*APIKeyResult, error) {
+	return a.CreateAPIKeyCtx(context.Background(), nonce)
+}
+
+// CreateAPIKeyCtx is like CreateAPIKey but bound to ctx
+func (a *AuthAPI) CreateAPIKeyCtx(ctx context.Context, nonce *big.Int) (*APIKeyResult, error) {
+	endpoint := "/auth/api-key"
+
+	l1Headers, err := a.signL1(ctx, nonce)
+	if err != nil {
+		return nil, err
 	}
 
 	// Build request body (although docs say these fields are needed, actually only L1 Header may be required)
 	req := &CreateAPICredentialsRequest{
-		Address:   address,
+		Address:   l1Headers.Address,
 		Timestamp: l1Headers.Timestamp,
 		Nonce:     l1Headers.Nonce,
 		Signature: l1Headers.Signature,
 	}
 
 	// Send request with L1 Header
-	data, err := a.client.PostWithL1(endpoint, req, l1Headers)
+	data, err := a.client.PostWithL1Ctx(ctx, endpoint, req, l1Headers)
 	if err != nil {
 		return nil, fmt.Errorf("create API credentials: %w", err)
 	}
 
-	var response CreateAPICredentialsResponse
-	if err := json.Unmarshal(data, &response); err != nil {
-		return nil, fmt.Errorf("unmarshal response: %w", err)
+	return a.apiKeyResult(ctx, http.MethodPost, endpoint, data, l1Headers, false)
+}
+
+// DeriveAPIKey derives the existing API key of a nonce (nil uses the default nonce 0)
+// Like CreateAPIKey, only the default nonce key is set on the client and saved in the credential store
+// This endpoint requires L1 Header
+// Reference: https://docs.polymarket.com/developers/CLOB/authentication
+func (a *AuthAPI) DeriveAPIKey(nonce *big.Int) (*APIKeyResult, error) {
+	return a.DeriveAPIKeyCtx(context.Background(), nonce)
+}
+
+// DeriveAPIKeyCtx is like DeriveAPIKey but bound to ctx
+func (a *AuthAPI) DeriveAPIKeyCtx(ctx context.Context, nonce *big.Int) (*APIKeyResult, error) {
+	endpoint := "/auth/derive-api-key"
+
+	l1Headers, err := a.signL1(ctx, nonce)
+	if err != nil {
+		return nil, err
 	}
 
-	creds := &APICredentials{
-		Key:        response.Key,
-		Secret:     response.Secret,
-		Passphrase: response.Passphrase,
+	// Send request with L1 Header
+	data, err := a.client.GetWithL1Ctx(ctx, endpoint, l1Headers)
+	if err != nil {
+		return nil, fmt.Errorf("derive API credentials: %w", err)
 	}
 
-	// Set to client
-	a.client.SetAPICredentials(creds.Key, creds.Secret, creds.Passphrase)
+	return a.apiKeyResult(ctx, http.MethodGet, endpoint, data, l1Headers, true)
+}
 
-	return creds, nil
+// ListAPIKeys lists the API keys of the authenticated address
+// This endpoint requires L2 Header
+// Reference: https://docs.polymarket.com/developers/CLOB/authentication
+func (a *AuthAPI) ListAPIKeys() (*APIKeysResult, error) {
+	return a.ListAPIKeysCtx(context.Background())
 }
 
-// DeriveAPICredentials derives API credentials
-// This endpoint requires L1 Header
+// ListAPIKeysCtx is like ListAPIKeys but bound to ctx
+func (a *AuthAPI) ListAPIKeysCtx(ctx context.Context) (*APIKeysResult, error) {
+	data, err := a.client.DoWithL2Ctx(ctx, http.MethodGet, "/auth/api-keys", nil)
+	if err != nil {
+		return nil, fmt.Errorf("list API keys: %w", err)
+	}
+
+	keys, err := parseAPIKeys(data)
+	if err != nil {
+		return nil, err
+	}
+	return &APIKeysResult{APIKeys: keys}, nil
+}
+
+// DeleteAPIKey deletes (revokes) the API key the client is authenticated with
+// The client credentials are cleared (and removed from the credential store), create or derive a new key to keep trading
+// This endpoint requires L2 Header
 // Reference: https://docs.polymarket.com/developers/CLOB/authentication
-func (a *AuthAPI) DeriveAPICredentials() (*APICredentials, error) {
-	endpoint := "/auth/derive-api-key"
+func (a *AuthAPI) DeleteAPIKey() (*DeleteAPIKeyResult, error) {
+	return a.DeleteAPIKeyCtx(context.Background())
+}
+
+// DeleteAPIKeyCtx is like DeleteAPIKey but bound to ctx
+func (a *AuthAPI) DeleteAPIKeyCtx(ctx context.Context) (*DeleteAPIKeyResult, error) {
+	key := a.client.GetAPIKey()
+	if _, err := a.client.DoWithL2Ctx(ctx, http.MethodDelete, "/auth/api-key", nil); err != nil {
+		return nil, fmt.Errorf("delete API key: %w", err)
+	}
 
-	// Generate L1 authentication signature
-	address := a.client.GetAddress()
-	timestamp := time.Now().Unix()
-	nonce := big.NewInt(0)
+	if err := a.client.ClearAPICredentials(ctx); err != nil {
+		return nil, err
+	}
+
+	return &DeleteAPIKeyResult{Key: key}, nil
+}
 
-	signer := a.client.GetSigner()
-	l1Headers, err := signer.SignL1Auth(address, timestamp, nonce)
+// CreateReadOnlyAPIKey creates a read-only API key for the authenticated address
+// This endpoint requires L2 Header
+// Reference: https://docs.polymarket.com/developers/CLOB/authentication
+func (a *AuthAPI) CreateReadOnlyAPIKey() (*ReadOnlyAPIKeyResult, error) {
+	return a.CreateReadOnlyAPIKeyCtx(context.Background())
+}
+
+// CreateReadOnlyAPIKeyCtx is like CreateReadOnlyAPIKey but bound to ctx
+func (a *AuthAPI) CreateReadOnlyAPIKeyCtx(ctx context.Context) (*ReadOnlyAPIKeyResult, error) {
+	data, err := a.client.DoWithL2Ctx(ctx, http.MethodPost, "/auth/readonly-api-key", nil)
+	if err != nil {
+		return nil, fmt.Errorf("create read-only API key: %w", err)
+	}
+
+	var result ReadOnlyAPIKeyResult
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("unmarshal response: %w", err)
+	}
+	return &result, nil
+}
+
+// ListReadOnlyAPIKeys lists the read-only API keys of the authenticated address
+// This endpoint requires L2 Header
+// Reference: https://docs.polymarket.com/developers/CLOB/authentication
+func (a *AuthAPI) ListReadOnlyAPIKeys() (*ReadOnlyAPIKeysResult, error) {
+	return a.ListReadOnlyAPIKeysCtx(context.Background())
+}
+
+// ListReadOnlyAPIKeysCtx is like ListReadOnlyAPIKeys but bound to ctx
+func (a *AuthAPI) ListReadOnlyAPIKeysCtx(ctx context.Context) (*ReadOnlyAPIKeysResult, error) {
+	data, err := a.client.DoWithL2Ctx(ctx, http.MethodGet, "/auth/readonly-api-keys", nil)
+	if err != nil {
+		return nil, fmt.Errorf("list read-only API keys: %w", err)
+	}
+
+	keys, err := parseAPIKeys(data)
+	if err != nil {
+		return nil, err
+	}
+	return &ReadOnlyAPIKeysResult{APIKeys: keys}, nil
+}
+
+// DeleteReadOnlyAPIKey deletes a read-only API key
+// This endpoint requires L2 Header
+// Reference: https://docs.polymarket.com/developers/CLOB/authentication
+func (a *AuthAPI) DeleteReadOnlyAPIKey(key string) (*DeleteAPIKeyResult, error) {
+	return a.DeleteReadOnlyAPIKeyCtx(context.Background(), key)
+}
+
+// DeleteReadOnlyAPIKeyCtx is like DeleteReadOnlyAPIKey but bound to ctx
+func (a *AuthAPI) DeleteReadOnlyAPIKeyCtx(ctx context.Context, key string) (*DeleteAPIKeyResult, error) {
+	if key == "" {
+		return nil, fmt.Errorf("key is required")
+	}
+
+	req := &deleteReadOnlyAPIKeyRequest{Key: key}
+	if _, err := a.client.DoWithL2Ctx(ctx, http.MethodDelete, "/auth/readonly-api-key", req); err != nil {
+		return nil, fmt.Errorf("delete read-only API key: %w", err)
+	}
+
+	return &DeleteAPIKeyResult{Key: key}, nil
+}
+
+// parseAPIKeys parses a list of API keys, either a bare array or {"apiKeys": [...]},
+// whose items are either key strings or credential objects
+func parseAPIKeys(data []byte) ([]string, error) {
+	var items []json.RawMessage
+	if err := json.Unmarshal(data, &items); err != nil {
+		var wrapped struct {
+			APIKeys []json.RawMessage `json:"apiKeys"`
+		}
+		if err := json.Unmarshal(data, &wrapped); err != nil {
+			return nil, fmt.Errorf("unmarshal response: %w", err)
+		}
+		items = wrapped.APIKeys
+	}
+
+	keys := make([]string, 0, len(items))
+	for _, item := range items {
+		var key string
+		if err := json.Unmarshal(item, &key); err != nil {
+			var creds struct {
+				Key    string `json:"key"`
+				APIKey string `json:"apiKey"`
+			}
+			if err := json.Unmarshal(item, &creds); err != nil {
+				return nil, fmt.Errorf("unmarshal API key: %w", err)
+			}
+			key = creds.Key
+			if key == "" {
+				key = creds.APIKey
+			}
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// signL1 generates the L1 authentication headers for a nonce, stamped with the server time
+func (a *AuthAPI) signL1(ctx context.Context, nonce *big.Int) (*auth.L1AuthHeaders, error) {
+	signer, err := a.client.RequireSigner()
+	if err != nil {
+		return nil, err
+	}
+	if nonce == nil {
+		nonce = big.NewInt(0)
+	}
+	if nonce.Sign() < 0 {
+		return nil, fmt.Errorf("nonce must not be negative")
+	}
+
+	a.client.EnsureTimeSync(ctx)
+	timestamp := a.client.Now().Unix()
+
+	l1Headers, err := auth.SignL1AuthCtx(ctx, signer, a.client.GetChainID(), a.client.GetAddress(), timestamp, nonce)
 	if err != nil {
 		return nil, fmt.Errorf("sign L1 auth: %w", err)
 	}
+	return l1Headers, nil
+}
 
-	// Send request with L1 Header
-	data, err := a.client.GetWithL1(endpoint, l1Headers)
+// refreshCredentials creates or derives the credentials of the default nonce (client credential refresher)
+func (a *AuthAPI) refreshCredentials(ctx context.Context) (*client.Credentials, error) {
+	result, err := a.CreateOrDeriveAPIKeyCtx(ctx, nil)
 	if err != nil {
-		return nil, fmt.Errorf("derive API credentials: %w", err)
+		return nil, err
 	}
+	return result.Credentials, nil
+}
 
+// apiKeyResult parses a create/derive response, the credentials of the default nonce are set on the client and
+// saved in the credential store, other nonces hold separate key sets that leave the client state untouched
+func (a *AuthAPI) apiKeyResult(ctx context.Context, method, endpoint string, data []byte, l1Headers *auth.L1AuthHeaders, derived bool) (*APIKeyResult, error) {
 	var response CreateAPICredentialsResponse
 	if err := json.Unmarshal(data, &response); err != nil {
 		return nil, fmt.Errorf("unmarshal response: %w", err)
 	}
+	if response.Key == "" {
+		return nil, client.NewAPIError(method, endpoint, http.StatusOK, data)
+	}
 
 	creds := &APICredentials{
 		Key:        response.Key,
@@ -137,8 +406,20 @@ func (a *AuthAPI) DeriveAPICredentials() (*APICredentials, error) {
 		Passphrase: response.Passphrase,
 	}
 
-	// Set to client
-	a.client.SetAPICredentials(creds.Key, creds.Secret, creds.Passphrase)
+	nonce, _ := new(big.Int).SetString(l1Headers.Nonce, 10)
+
+	// Set to client, a failure to persist them is not fatal as they can be derived again
+	if nonce != nil && nonce.Sign() == 0 {
+		if err := a.client.SaveAPICredentials(ctx, creds); err != nil {
+			a.client.Logger().LogAttrs(ctx, slog.LevelWarn, "polymarket credentials not saved",
+				slog.String("error", err.Error()))
+		}
+	}
 
-	return creds, nil
+	return &APIKeyResult{
+		Credentials: creds,
+		Address:     l1Headers.Address,
+		Nonce:       nonce,
+		Derived:     derived,
+	}, nil
 }
@@ -0,0 +1,130 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/mtt-labs/poly-market-sdk/client"
+)
+
+// newAPIKeyServer serves /auth/api-key and /auth/derive-api-key with credentials named after the L1 nonce
+func newAPIKeyServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		nonce := r.Header.Get("POLY_NONCE")
+		json.NewEncoder(w).Encode(&CreateAPICredentialsResponse{
+			Key:        "key-" + nonce,
+			Secret:     "c2VjcmV0LQ==",
+			Passphrase: "passphrase-" + nonce,
+		})
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func newTestAuthAPI(t *testing.T, baseURL string, store client.CredentialStore) (*AuthAPI, *client.Client) {
+	t.Helper()
+	c := newTestClient(t, baseURL, func(config *client.Config) {
+		config.CredentialStore = store
+	})
+	return NewAuthAPI(c), c
+}
+
+func TestCreateAPIKeyDefaultNonceSetsClientCredentials(t *testing.T) {
+	server := newAPIKeyServer(t)
+	store := client.NewMemoryCredentialStore()
+	authAPI, c := newTestAuthAPI(t, server.URL, store)
+
+	result, err := authAPI.CreateAPIKey(nil)
+	if err != nil {
+		t.Fatalf("CreateAPIKey: %v", err)
+	}
+	if result.Credentials.Key != "key-0" || result.Nonce.Sign() != 0 || result.Derived {
+		t.Errorf("result = %+v, want key-0 created for nonce 0", result)
+	}
+	if got := c.GetAPIKey(); got != "key-0" {
+		t.Errorf("client API key = %q, want key-0", got)
+	}
+	stored, err := store.Load(context.Background(), c.GetAddress(), nil)
+	if err != nil || stored.Key != "key-0" {
+		t.Errorf("stored credentials = %v, %v, want key-0", stored, err)
+	}
+}
+
+func TestAPIKeyOtherNonceLeavesClientCredentials(t *testing.T) {
+	server := newAPIKeyServer(t)
+	store := client.NewMemoryCredentialStore()
+	authAPI, c := newTestAuthAPI(t, server.URL, store)
+
+	if _, err := authAPI.CreateAPIKey(nil); err != nil {
+		t.Fatalf("CreateAPIKey: %v", err)
+	}
+
+	created, err := authAPI.CreateAPIKey(big.NewInt(1))
+	if err != nil {
+		t.Fatalf("CreateAPIKey(1): %v", err)
+	}
+	derived, err := authAPI.DeriveAPIKey(big.NewInt(2))
+	if err != nil {
+		t.Fatalf("DeriveAPIKey(2): %v", err)
+	}
+	if created.Credentials.Key != "key-1" || created.Nonce.Cmp(big.NewInt(1)) != 0 {
+		t.Errorf("created = %+v, want key-1 for nonce 1", created)
+	}
+	if derived.Credentials.Key != "key-2" || !derived.Derived {
+		t.Errorf("derived = %+v, want key-2 derived", derived)
+	}
+
+	if got := c.APICredentials(); got.Key != "key-0" || got.Passphrase != "passphrase-0" {
+		t.Errorf("client credentials = %v, want the nonce 0 set", &got)
+	}
+	stored, err := store.Load(context.Background(), c.GetAddress(), nil)
+	if err != nil || stored.Key != "key-0" {
+		t.Errorf("stored credentials = %v, %v, want key-0", stored, err)
+	}
+}
+
+func TestCreateAPIKeyReadOnlyClient(t *testing.T) {
+	c, err := client.NewPublicClient(nil)
+	if err != nil {
+		t.Fatalf("NewPublicClient: %v", err)
+	}
+	if _, err := NewAuthAPI(c).CreateAPIKey(nil); !errors.Is(err, client.ErrNoSigner) {
+		t.Errorf("CreateAPIKey error = %v, want ErrNoSigner", err)
+	}
+}
+
+func TestCreateAPIKeyUsesServerClock(t *testing.T) {
+	serverTime := time.Now().Add(-3 * time.Hour).Unix()
+	var timestamp string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/time" {
+			fmt.Fprint(w, serverTime)
+			return
+		}
+		timestamp = r.Header.Get("POLY_TIMESTAMP")
+		json.NewEncoder(w).Encode(&CreateAPICredentialsResponse{Key: "key", Secret: "c2VjcmV0LQ==", Passphrase: "passphrase"})
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL, func(config *client.Config) {
+		config.TimeSync = &client.TimeSyncConfig{}
+	})
+	if _, err := NewAuthAPI(c).CreateAPIKey(nil); err != nil {
+		t.Fatalf("CreateAPIKey: %v", err)
+	}
+
+	// The L1 timestamp follows the server clock, 3 hours behind the local one
+	got, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil || got < serverTime || got > serverTime+2 {
+		t.Errorf("POLY_TIMESTAMP = %q, want about %d", timestamp, serverTime)
+	}
+}
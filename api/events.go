@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -21,13 +22,18 @@ type EventsAPI struct {
 // NewEventsAPI creates a new EventsAPI instance
 func NewEventsAPI(c *client.Client) *EventsAPI {
 	return &EventsAPI{
-		gammaClient: client.NewGammaClient(),
+		gammaClient: c.GammaClient(),
 	}
 }
 
 // ListEvents lists events with optional filters
 // Reference: https://docs.polymarket.com/api-reference/events/list-events
 func (e *EventsAPI) ListEvents(params *models.ListEventsParams) ([]models.Event, error) {
+	return e.ListEventsCtx(context.Background(), params)
+}
+
+// ListEventsCtx is like ListEvents but bound to ctx
+func (e *EventsAPI) ListEventsCtx(ctx context.Context, params *models.ListEventsParams) ([]models.Event, error) {
 	endpoint := "/events"
 
 	// Build query parameters
@@ -100,7 +106,7 @@ func (e *EventsAPI) ListEvents(params *models.ListEventsParams) ([]models.Event,
 		endpoint = endpoint + "?" + queryValues.Encode()
 	}
 
-	data, err := e.gammaClient.Get(endpoint)
+	data, err := e.gammaClient.GetCtx(ctx, endpoint)
 	if err != nil {
 		return nil, fmt.Errorf("list events: %w", err)
 	}
@@ -116,9 +122,14 @@ func (e *EventsAPI) ListEvents(params *models.ListEventsParams) ([]models.Event,
 // GetEventByID gets an event by ID
 // Reference: https://docs.polymarket.com/api-reference/events/get-event-by-id
 func (e *EventsAPI) GetEventByID(eventID string) (*models.Event, error) {
+	return e.GetEventByIDCtx(context.Background(), eventID)
+}
+
+// GetEventByIDCtx is like GetEventByID but bound to ctx
+func (e *EventsAPI) GetEventByIDCtx(ctx context.Context, eventID string) (*models.Event, error) {
 	endpoint := fmt.Sprintf("/events/%s", eventID)
 
-	data, err := e.gammaClient.Get(endpoint)
+	data, err := e.gammaClient.GetCtx(ctx, endpoint)
 	if err != nil {
 		return nil, fmt.Errorf("get event by id: %w", err)
 	}
@@ -134,9 +145,14 @@ func (e *EventsAPI) GetEventByID(eventID string) (*models.Event, error) {
 // GetEventBySlug gets an event by slug
 // Reference: https://docs.polymarket.com/api-reference/events/get-event-by-slug
 func (e *EventsAPI) GetEventBySlug(slug string) (*models.Event, error) {
+	return e.GetEventBySlugCtx(context.Background(), slug)
+}
+
+// GetEventBySlugCtx is like GetEventBySlug but bound to ctx
+func (e *EventsAPI) GetEventBySlugCtx(ctx context.Context, slug string) (*models.Event, error) {
 	endpoint := fmt.Sprintf("/events/slug/%s", slug)
 
-	data, err := e.gammaClient.Get(endpoint)
+	data, err := e.gammaClient.GetCtx(ctx, endpoint)
 	if err != nil {
 		return nil, fmt.Errorf("get event by slug: %w", err)
 	}
@@ -152,9 +168,14 @@ func (e *EventsAPI) GetEventBySlug(slug string) (*models.Event, error) {
 // GetEventTags gets tags related to an event
 // Reference: https://docs.polymarket.com/api-reference/events/get-event-tags
 func (e *EventsAPI) GetEventTags(tagSlug string) ([]models.EventTag, error) {
+	return e.GetEventTagsCtx(context.Background(), tagSlug)
+}
+
+// GetEventTagsCtx is like GetEventTags but bound to ctx
+func (e *EventsAPI) GetEventTagsCtx(ctx context.Context, tagSlug string) ([]models.EventTag, error) {
 	endpoint := fmt.Sprintf("/events/tags/%s", tagSlug)
 
-	data, err := e.gammaClient.Get(endpoint)
+	data, err := e.gammaClient.GetCtx(ctx, endpoint)
 	if err != nil {
 		return nil, fmt.Errorf("get event tags: %w", err)
 	}
@@ -0,0 +1,153 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/mtt-labs/poly-market-sdk/client"
+	"github.com/mtt-labs/poly-market-sdk/models"
+)
+
+// MarketDataAPI provides public CLOB market data (orderbook and pricing)
+// These endpoints require no authentication and work with a read-only client
+// Reference: https://docs.polymarket.com/api-reference/orderbook/get-order-book-summary
+type MarketDataAPI struct {
+	client *client.Client
+}
+
+// NewMarketDataAPI creates a new MarketDataAPI instance
+func NewMarketDataAPI(c *client.Client) *MarketDataAPI {
+	return &MarketDataAPI{client: c}
+}
+
+// GetOrderBook gets the orderbook of a token
+// Reference: https://docs.polymarket.com/api-reference/orderbook/get-order-book-summary
+func (m *MarketDataAPI) GetOrderBook(tokenID string) (*models.OrderBookSummary, error) {
+	return m.GetOrderBookCtx(context.Background(), tokenID)
+}
+
+// GetOrderBookCtx is like GetOrderBook but bound to ctx
+func (m *MarketDataAPI) GetOrderBookCtx(ctx context.Context, tokenID string) (*models.OrderBookSummary, error) {
+	endpoint := "/book"
+	queryValues := url.Values{}
+	queryValues.Set("token_id", tokenID)
+	endpoint = endpoint + "?" + queryValues.Encode()
+
+	data, err := m.client.GetCtx(ctx, endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("get order book: %w", err)
+	}
+
+	var book models.OrderBookSummary
+	if err := json.Unmarshal(data, &book); err != nil {
+		return nil, fmt.Errorf("unmarshal response: %w", err)
+	}
+
+	return &book, nil
+}
+
+// GetPrice gets the best price of a token for a side ("BUY" or "SELL")
+// Reference: https://docs.polymarket.com/api-reference/pricing/get-market-price
+func (m *MarketDataAPI) GetPrice(tokenID, side string) (*models.PriceResponse, error) {
+	return m.GetPriceCtx(context.Background(), tokenID, side)
+}
+
+// GetPriceCtx is like GetPrice but bound to ctx
+func (m *MarketDataAPI) GetPriceCtx(ctx context.Context, tokenID, side string) (*models.PriceResponse, error) {
+	endpoint := "/price"
+	queryValues := url.Values{}
+	queryValues.Set("token_id", tokenID)
+	queryValues.Set("side", side)
+	endpoint = endpoint + "?" + queryValues.Encode()
+
+	data, err := m.client.GetCtx(ctx, endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("get price: %w", err)
+	}
+
+	var response models.PriceResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("unmarshal response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// GetMidpoint gets the midpoint price of a token
+// Reference: https://docs.polymarket.com/api-reference/pricing/get-midpoint-price
+func (m *MarketDataAPI) GetMidpoint(tokenID string) (*models.MidpointResponse, error) {
+	return m.GetMidpointCtx(context.Background(), tokenID)
+}
+
+// GetMidpointCtx is like GetMidpoint but bound to ctx
+func (m *MarketDataAPI) GetMidpointCtx(ctx context.Context, tokenID string) (*models.MidpointResponse, error) {
+	endpoint := "/midpoint"
+	queryValues := url.Values{}
+	queryValues.Set("token_id", tokenID)
+	endpoint = endpoint + "?" + queryValues.Encode()
+
+	data, err := m.client.GetCtx(ctx, endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("get midpoint: %w", err)
+	}
+
+	var response models.MidpointResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("unmarshal response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// GetSpread gets the bid-ask spread of a token
+// Reference: https://docs.polymarket.com/api-reference/spreads/get-bid-ask-spreads
+func (m *MarketDataAPI) GetSpread(tokenID string) (*models.SpreadResponse, error) {
+	return m.GetSpreadCtx(context.Background(), tokenID)
+}
+
+// GetSpreadCtx is like GetSpread but bound to ctx
+func (m *MarketDataAPI) GetSpreadCtx(ctx context.Context, tokenID string) (*models.SpreadResponse, error) {
+	endpoint := "/spread"
+	queryValues := url.Values{}
+	queryValues.Set("token_id", tokenID)
+	endpoint = endpoint + "?" + queryValues.Encode()
+
+	data, err := m.client.GetCtx(ctx, endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("get spread: %w", err)
+	}
+
+	var response models.SpreadResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("unmarshal response: %w", err)
+	}
+
+	return &response, nil
+}
+
+// GetLastTradePrice gets the last trade price of a token
+func (m *MarketDataAPI) GetLastTradePrice(tokenID string) (*models.LastTradePriceResponse, error) {
+	return m.GetLastTradePriceCtx(context.Background(), tokenID)
+}
+
+// GetLastTradePriceCtx is like GetLastTradePrice but bound to ctx
+func (m *MarketDataAPI) GetLastTradePriceCtx(ctx context.Context, tokenID string) (*models.LastTradePriceResponse, error) {
+	endpoint := "/last-trade-price"
+	queryValues := url.Values{}
+	queryValues.Set("token_id", tokenID)
+	endpoint = endpoint + "?" + queryValues.Encode()
+
+	data, err := m.client.GetCtx(ctx, endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("get last trade price: %w", err)
+	}
+
+	var response models.LastTradePriceResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("unmarshal response: %w", err)
+	}
+
+	return &response, nil
+}
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -21,7 +22,7 @@ type MarketsAPI struct {
 // NewMarketsAPI creates a new MarketsAPI instance
 func NewMarketsAPI(c *client.Client) *MarketsAPI {
 	return &MarketsAPI{
-		gammaClient: client.NewGammaClient(),
+		gammaClient: c.GammaClient(),
 	}
 }
 
@@ -61,6 +62,11 @@ type ListMarketsParams struct {
 // Returns an array of markets directly
 // Reference: https://docs.polymarket.com/api-reference/markets/list-markets
 func (m *MarketsAPI) GetMarkets(params *ListMarketsParams) ([]models.Market, error) {
+	return m.GetMarketsCtx(context.Background(), params)
+}
+
+// GetMarketsCtx is like GetMarkets but bound to ctx
+func (m *MarketsAPI) GetMarketsCtx(ctx context.Context, params *ListMarketsParams) ([]models.Market, error) {
 	endpoint := "/markets"
 
 	// Build query parameters
@@ -168,7 +174,7 @@ func (m *MarketsAPI) GetMarkets(params *ListMarketsParams) ([]models.Market, err
 		endpoint = endpoint + "?" + queryValues.Encode()
 	}
 
-	data, err := m.gammaClient.Get(endpoint)
+	data, err := m.gammaClient.GetCtx(ctx, endpoint)
 	if err != nil {
 		return nil, fmt.Errorf("get markets: %w", err)
 	}
@@ -185,9 +191,14 @@ func (m *MarketsAPI) GetMarkets(params *ListMarketsParams) ([]models.Market, err
 // GetMarketByID gets market details by ID
 // Reference: https://docs.polymarket.com/api-reference/markets/get-market-by-id
 func (m *MarketsAPI) GetMarketByID(marketID string) (*models.Market, error) {
+	return m.GetMarketByIDCtx(context.Background(), marketID)
+}
+
+// GetMarketByIDCtx is like GetMarketByID but bound to ctx
+func (m *MarketsAPI) GetMarketByIDCtx(ctx context.Context, marketID string) (*models.Market, error) {
 	endpoint := fmt.Sprintf("/markets/%s", marketID)
 
-	data, err := m.gammaClient.Get(endpoint)
+	data, err := m.gammaClient.GetCtx(ctx, endpoint)
 	if err != nil {
 		return nil, fmt.Errorf("get market: %w", err)
 	}
@@ -204,9 +215,14 @@ func (m *MarketsAPI) GetMarketByID(marketID string) (*models.Market, error) {
 // GetMarketBySlug gets market details by slug
 // Reference: https://docs.polymarket.com/api-reference/markets/get-market-by-slug
 func (m *MarketsAPI) GetMarketBySlug(slug string) (*models.Market, error) {
+	return m.GetMarketBySlugCtx(context.Background(), slug)
+}
+
+// GetMarketBySlugCtx is like GetMarketBySlug but bound to ctx
+func (m *MarketsAPI) GetMarketBySlugCtx(ctx context.Context, slug string) (*models.Market, error) {
 	endpoint := fmt.Sprintf("/markets/slug/%s", slug)
 
-	data, err := m.gammaClient.Get(endpoint)
+	data, err := m.gammaClient.GetCtx(ctx, endpoint)
 	if err != nil {
 		return nil, fmt.Errorf("get market by slug: %w", err)
 	}
@@ -223,9 +239,14 @@ func (m *MarketsAPI) GetMarketBySlug(slug string) (*models.Market, error) {
 // GetMarketTags gets tags for a market by ID
 // Reference: https://docs.polymarket.com/api-reference/markets/get-market-tags-by-id
 func (m *MarketsAPI) GetMarketTags(marketID string) ([]models.EventTag, error) {
+	return m.GetMarketTagsCtx(context.Background(), marketID)
+}
+
+// GetMarketTagsCtx is like GetMarketTags but bound to ctx
+func (m *MarketsAPI) GetMarketTagsCtx(ctx context.Context, marketID string) ([]models.EventTag, error) {
 	endpoint := fmt.Sprintf("/markets/%s/tags", marketID)
 
-	data, err := m.gammaClient.Get(endpoint)
+	data, err := m.gammaClient.GetCtx(ctx, endpoint)
 	if err != nil {
 		return nil, fmt.Errorf("get market tags: %w", err)
 	}
@@ -0,0 +1,274 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/mtt-labs/poly-market-sdk/auth"
+	"github.com/mtt-labs/poly-market-sdk/client"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/polymarket/go-order-utils/pkg/contracts/exchange"
+)
+
+// ChainBackend Polygon node access used by on-chain helpers (an *ethclient.Client implements it)
+type ChainBackend interface {
+	bind.ContractBackend
+	bind.DeployBackend
+}
+
+// NonceManager reads and increments the maker's nonces on the CTF exchanges
+//
+// Orders are only valid while their nonce equals the maker's current exchange nonce. Incrementing the
+// nonce on-chain invalidates every outstanding order signed with the previous one at once, which works
+// even when the CLOB API is unreachable. The CTF Exchange and the NegRisk CTF Exchange keep separate nonces.
+// Reference: https://github.com/Polymarket/ctf-exchange/blob/main/src/exchange/mixins/NonceManager.sol
+type NonceManager struct {
+	client  *client.Client
+	mu      sync.Mutex
+	backend ChainBackend      // Dialed from the client's RPC URL on first use unless provided
+	nonces  map[bool]*big.Int // Cached nonces, keyed by negRisk
+	pending map[bool]*big.Int // Nonces set by increments sent but not seen on-chain yet, keyed by negRisk
+}
+
+// NewNonceManager creates a NonceManager using the client's RPC URL (client.Config.RPCURL)
+// The node is dialed on first use
+func NewNonceManager(c *client.Client) *NonceManager {
+	return &NonceManager{
+		client:  c,
+		nonces:  make(map[bool]*big.Int),
+		pending: make(map[bool]*big.Int),
+	}
+}
+
+// NewNonceManagerWithBackend creates a NonceManager using a given node connection
+func NewNonceManagerWithBackend(c *client.Client, backend ChainBackend) *NonceManager {
+	n := NewNonceManager(c)
+	n.backend = backend
+	return n
+}
+
+// Nonce returns the maker's current nonce on the exchange (negRisk selects the NegRisk CTF Exchange)
+// The nonce is read on-chain once and cached until it is incremented or refreshed. After IncrementNonce
+// the incremented nonce is returned right away, before the transaction is mined, so new orders are never
+// signed with the nonce being invalidated. Such orders become valid once the transaction is mined.
+func (n *NonceManager) Nonce(ctx context.Context, negRisk bool) (*big.Int, error) {
+	n.mu.Lock()
+	nonce, ok := n.nonces[negRisk]
+	n.mu.Unlock()
+	if ok {
+		return new(big.Int).Set(nonce), nil
+	}
+	return n.RefreshNonce(ctx, negRisk)
+}
+
+// RefreshNonce reads the maker's current nonce on the exchange and updates the cache
+// While an increment sent by IncrementNonce is not mined, the incremented nonce is returned instead
+func (n *NonceManager) RefreshNonce(ctx context.Context, negRisk bool) (*big.Int, error) {
+	maker, err := n.maker()
+	if err != nil {
+		return nil, err
+	}
+	contract, err := n.exchange(ctx, negRisk)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce, err := contract.Nonces(&bind.CallOpts{Context: ctx}, maker)
+	if err != nil {
+		return nil, fmt.Errorf("read exchange nonce: %w", err)
+	}
+
+	n.mu.Lock()
+	if pending, ok := n.pending[negRisk]; ok {
+		if nonce.Cmp(pending) < 0 {
+			nonce = pending
+		} else {
+			delete(n.pending, negRisk)
+		}
+	}
+	n.nonces[negRisk] = nonce
+	n.mu.Unlock()
+	return new(big.Int).Set(nonce), nil
+}
+
+// IncrementNonce sends an incrementNonce transaction to the exchange, invalidating every order of the
+// maker signed with the current nonce once mined. Until then Nonce returns the incremented nonce, use
+// WaitMined to wait for the transaction. The transaction is sent from the signer, so the
+// maker must be the signer address (SignatureTypeEOA); proxy wallets and Safes must execute
+// IncrementNonceCalldata through the wallet instead. The signer must implement auth.TransactionSigner.
+func (n *NonceManager) IncrementNonce(ctx context.Context, negRisk bool) (*types.Transaction, error) {
+	opts, err := n.transactOpts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	contract, err := n.exchange(ctx, negRisk)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := n.Nonce(ctx, negRisk)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := contract.IncrementNonce(opts)
+	if err != nil {
+		return nil, fmt.Errorf("increment exchange nonce: %w", err)
+	}
+
+	// The nonce changes once the transaction is mined, orders signed meanwhile use the incremented nonce
+	next := nonce.Add(nonce, big.NewInt(1))
+	n.mu.Lock()
+	n.nonces[negRisk] = next
+	n.pending[negRisk] = next
+	n.mu.Unlock()
+	return tx, nil
+}
+
+// InvalidateAllOrders increments the nonce on both the CTF Exchange and the NegRisk CTF Exchange,
+// invalidating every outstanding order of the maker once the transactions are mined
+// The transactions sent before an error are returned with it
+func (n *NonceManager) InvalidateAllOrders(ctx context.Context) ([]*types.Transaction, error) {
+	var txs []*types.Transaction
+	for _, negRisk := range []bool{false, true} {
+		tx, err := n.IncrementNonce(ctx, negRisk)
+		if err != nil {
+			return txs, err
+		}
+		txs = append(txs, tx)
+	}
+	return txs, nil
+}
+
+// WaitMined waits for a nonce transaction to be mined and drops the cached nonce of its exchange, which is
+// read again on next use. A reverted transaction also drops the incremented nonce cached by IncrementNonce.
+func (n *NonceManager) WaitMined(ctx context.Context, tx *types.Transaction) (*types.Receipt, error) {
+	backend, err := n.chain(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	receipt, err := bind.WaitMined(ctx, backend, tx)
+	if err != nil {
+		return nil, fmt.Errorf("wait for transaction %s: %w", tx.Hash().Hex(), err)
+	}
+
+	negRisk := tx.To() != nil && *tx.To() == n.client.Network().Contracts.NegRiskExchange
+	n.mu.Lock()
+	delete(n.nonces, negRisk)
+	delete(n.pending, negRisk)
+	n.mu.Unlock()
+
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return receipt, fmt.Errorf("transaction %s reverted", tx.Hash().Hex())
+	}
+	return receipt, nil
+}
+
+// IncrementNonceCalldata returns the calldata of incrementNonce(), to be executed on an exchange
+// (Network().Contracts.Exchange or NegRiskExchange) by a proxy wallet or Safe maker
+func IncrementNonceCalldata() ([]byte, error) {
+	parsed, err := exchange.ExchangeMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("parse exchange ABI: %w", err)
+	}
+	return parsed.Pack("incrementNonce")
+}
+
+// maker returns the address whose nonce orders are signed with (the funder)
+func (n *NonceManager) maker() (common.Address, error) {
+	if n.client.IsReadOnly() {
+		return common.Address{}, client.ErrNoSigner
+	}
+	funder := n.client.GetFunder()
+	if funder == "" {
+		funder = n.client.GetAddress()
+	}
+	return common.HexToAddress(funder), nil
+}
+
+// exchange returns the binding of the CTF Exchange or of the NegRisk CTF Exchange
+// Both exchanges share the nonce manager interface, so the same binding serves both
+func (n *NonceManager) exchange(ctx context.Context, negRisk bool) (*exchange.Exchange, error) {
+	backend, err := n.chain(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	contracts := n.client.Network().Contracts
+	address := contracts.Exchange
+	if negRisk {
+		address = contracts.NegRiskExchange
+	}
+
+	contract, err := exchange.NewExchange(address, backend)
+	if err != nil {
+		return nil, fmt.Errorf("bind exchange %s: %w", address.Hex(), err)
+	}
+	return contract, nil
+}
+
+// chain returns the node connection, dialing the client's RPC URL on first use
+func (n *NonceManager) chain(ctx context.Context) (ChainBackend, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if n.backend != nil {
+		return n.backend, nil
+	}
+	rpcURL := n.client.RPCURL()
+	if rpcURL == "" {
+		return nil, fmt.Errorf("RPC URL is required for on-chain nonces")
+	}
+
+	backend, err := ethclient.DialContext(ctx, rpcURL)
+	if err != nil {
+		return nil, fmt.Errorf("dial %s: %w", rpcURL, err)
+	}
+	n.backend = backend
+	return backend, nil
+}
+
+// transactOpts returns transaction options signing with the client's signer
+func (n *NonceManager) transactOpts(ctx context.Context) (*bind.TransactOpts, error) {
+	signer, err := n.client.RequireSigner()
+	if err != nil {
+		return nil, err
+	}
+	txSigner, ok := signer.(auth.TransactionSigner)
+	if !ok {
+		return nil, fmt.Errorf("signer %T cannot sign transactions", signer)
+	}
+
+	from := common.HexToAddress(signer.Address())
+	maker, err := n.maker()
+	if err != nil {
+		return nil, err
+	}
+	if maker != from {
+		return nil, fmt.Errorf("nonce of maker %s can only be incremented by that wallet, execute IncrementNonceCalldata through it", maker.Hex())
+	}
+
+	chainSigner := types.LatestSignerForChainID(big.NewInt(int64(n.client.GetChainID())))
+	return &bind.TransactOpts{
+		From:    from,
+		Context: ctx,
+		Signer: func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+			if address != from {
+				return nil, bind.ErrNotAuthorized
+			}
+			signature, err := auth.SignTransactionHashCtx(ctx, txSigner, chainSigner.Hash(tx))
+			if err != nil {
+				return nil, err
+			}
+			signature = append([]byte(nil), signature...)
+			signature[64] -= 27
+			return tx.WithSignature(chainSigner, signature)
+		},
+	}, nil
+}
@@ -0,0 +1,307 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/mtt-labs/poly-market-sdk/client"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/polymarket/go-order-utils/pkg/contracts/exchange"
+)
+
+// simulatedChain in-memory chain running the nonce manager of the exchanges
+// Sent transactions are checked like a node would (chain ID, signature, account nonce) and stay pending until mine
+type simulatedChain struct {
+	mu       sync.Mutex
+	abi      *abi.ABI
+	chainID  *big.Int
+	code     map[common.Address]bool                        // Deployed exchanges
+	nonces   map[common.Address]map[common.Address]*big.Int // Exchange nonces, keyed by exchange and maker
+	accounts map[common.Address]uint64                      // Account nonces
+	pending  []*types.Transaction
+	receipts map[common.Hash]*types.Receipt
+	revert   bool // Mined increments revert
+}
+
+func newSimulatedChain(t *testing.T, chainID int, exchanges ...common.Address) *simulatedChain {
+	t.Helper()
+	parsed, err := exchange.ExchangeMetaData.GetAbi()
+	if err != nil {
+		t.Fatalf("exchange ABI: %v", err)
+	}
+	s := &simulatedChain{
+		abi:      parsed,
+		chainID:  big.NewInt(int64(chainID)),
+		code:     make(map[common.Address]bool),
+		nonces:   make(map[common.Address]map[common.Address]*big.Int),
+		accounts: make(map[common.Address]uint64),
+		receipts: make(map[common.Hash]*types.Receipt),
+	}
+	for _, address := range exchanges {
+		s.code[address] = true
+		s.nonces[address] = make(map[common.Address]*big.Int)
+	}
+	return s
+}
+
+// nonce returns the exchange nonce of a maker
+func (s *simulatedChain) nonce(exchange, maker common.Address) *big.Int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if nonce, ok := s.nonces[exchange][maker]; ok {
+		return new(big.Int).Set(nonce)
+	}
+	return new(big.Int)
+}
+
+// mine executes the pending transactions
+func (s *simulatedChain) mine() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	signer := types.LatestSignerForChainID(s.chainID)
+	for _, tx := range s.pending {
+		from, _ := types.Sender(signer, tx)
+		status := types.ReceiptStatusFailed
+		if !s.revert {
+			nonce, ok := s.nonces[*tx.To()][from]
+			if !ok {
+				nonce = new(big.Int)
+			}
+			s.nonces[*tx.To()][from] = nonce.Add(nonce, big.NewInt(1))
+			status = types.ReceiptStatusSuccessful
+		}
+		s.receipts[tx.Hash()] = &types.Receipt{Status: status, TxHash: tx.Hash(), BlockNumber: big.NewInt(1)}
+	}
+	s.pending = nil
+}
+
+func (s *simulatedChain) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return s.PendingCodeAt(ctx, contract)
+}
+
+func (s *simulatedChain) PendingCodeAt(ctx context.Context, contract common.Address) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.code[contract] {
+		return []byte{0x60}, nil
+	}
+	return nil, nil
+}
+
+func (s *simulatedChain) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	if code, _ := s.CodeAt(ctx, *call.To, blockNumber); len(code) == 0 {
+		return nil, nil
+	}
+	method, err := s.abi.MethodById(call.Data)
+	if err != nil || method.Name != "nonces" {
+		return nil, fmt.Errorf("execution reverted")
+	}
+	args, err := method.Inputs.Unpack(call.Data[4:])
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(s.nonce(*call.To, args[0].(common.Address)))
+}
+
+func (s *simulatedChain) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return &types.Header{Number: big.NewInt(1), BaseFee: big.NewInt(30_000_000_000)}, nil
+}
+
+func (s *simulatedChain) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.accounts[account], nil
+}
+
+func (s *simulatedChain) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return big.NewInt(30_000_000_000), nil
+}
+
+func (s *simulatedChain) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	return big.NewInt(30_000_000_000), nil
+}
+
+func (s *simulatedChain) EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error) {
+	return 50_000, nil
+}
+
+func (s *simulatedChain) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if tx.ChainId().Cmp(s.chainID) != 0 {
+		return fmt.Errorf("invalid chain id %s", tx.ChainId())
+	}
+	from, err := types.Sender(types.LatestSignerForChainID(s.chainID), tx)
+	if err != nil {
+		return fmt.Errorf("invalid sender: %w", err)
+	}
+	if tx.Nonce() != s.accounts[from] {
+		return fmt.Errorf("nonce %d of %s, want %d", tx.Nonce(), from.Hex(), s.accounts[from])
+	}
+	if tx.To() == nil || !s.code[*tx.To()] {
+		return fmt.Errorf("transaction to an unknown contract")
+	}
+	if method, err := s.abi.MethodById(tx.Data()); err != nil || method.Name != "incrementNonce" {
+		return fmt.Errorf("unexpected call %x", tx.Data())
+	}
+	s.accounts[from]++
+	s.pending = append(s.pending, tx)
+	return nil
+}
+
+func (s *simulatedChain) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if receipt, ok := s.receipts[txHash]; ok {
+		return receipt, nil
+	}
+	return nil, ethereum.NotFound
+}
+
+func (s *simulatedChain) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	return nil, errors.New("logs are not supported")
+}
+
+func (s *simulatedChain) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	return nil, errors.New("logs are not supported")
+}
+
+// newTestNonceManager creates a nonce manager of the test key on a simulated Polygon chain
+func newTestNonceManager(t *testing.T, opts ...func(*client.Config)) (*NonceManager, *simulatedChain, *client.Client) {
+	t.Helper()
+	c := newTestClient(t, "http://127.0.0.1:0", opts...)
+	contracts := c.Network().Contracts
+	chain := newSimulatedChain(t, c.GetChainID(), contracts.Exchange, contracts.NegRiskExchange)
+	return NewNonceManagerWithBackend(c, chain), chain, c
+}
+
+// expectNonce checks the nonce returned by the manager
+func expectNonce(t *testing.T, name string, n *NonceManager, negRisk bool, want int64) {
+	t.Helper()
+	nonce, err := n.Nonce(context.Background(), negRisk)
+	if err != nil {
+		t.Fatalf("%s: Nonce: %v", name, err)
+	}
+	if nonce.Int64() != want {
+		t.Errorf("%s: nonce = %s, want %d", name, nonce, want)
+	}
+}
+
+func TestNonceManagerNonceAndRefresh(t *testing.T) {
+	ctx := context.Background()
+	n, chain, c := newTestNonceManager(t)
+	maker := common.HexToAddress(c.GetAddress())
+	exchangeAddress := c.Network().Contracts.Exchange
+
+	chain.nonces[exchangeAddress][maker] = big.NewInt(3)
+	expectNonce(t, "first read", n, false, 3)
+	expectNonce(t, "neg risk exchange", n, true, 0)
+
+	// Changed on-chain by another process, the cached nonce is used until refreshed
+	chain.nonces[exchangeAddress][maker] = big.NewInt(5)
+	expectNonce(t, "cached", n, false, 3)
+	nonce, err := n.RefreshNonce(ctx, false)
+	if err != nil || nonce.Int64() != 5 {
+		t.Fatalf("RefreshNonce = %s, %v, want 5", nonce, err)
+	}
+	expectNonce(t, "refreshed", n, false, 5)
+
+	// The returned nonce is a copy
+	nonce.SetInt64(42)
+	expectNonce(t, "after mutating a returned nonce", n, false, 5)
+}
+
+func TestNonceManagerIncrementNonce(t *testing.T) {
+	ctx := context.Background()
+	n, chain, c := newTestNonceManager(t)
+	maker := common.HexToAddress(c.GetAddress())
+	contracts := c.Network().Contracts
+	chain.nonces[contracts.Exchange][maker] = big.NewInt(2)
+
+	txs, err := n.InvalidateAllOrders(ctx)
+	if err != nil || len(txs) != 2 {
+		t.Fatalf("InvalidateAllOrders = %d transactions, %v, want 2", len(txs), err)
+	}
+	if *txs[0].To() != contracts.Exchange || *txs[1].To() != contracts.NegRiskExchange {
+		t.Errorf("transactions sent to %s and %s, want the exchanges", txs[0].To().Hex(), txs[1].To().Hex())
+	}
+
+	// Not mined yet: orders signed now use the incremented nonces, even after a refresh
+	expectNonce(t, "pending", n, false, 3)
+	expectNonce(t, "pending neg risk", n, true, 1)
+	if nonce, err := n.RefreshNonce(ctx, false); err != nil || nonce.Int64() != 3 {
+		t.Errorf("RefreshNonce while pending = %s, %v, want 3", nonce, err)
+	}
+
+	chain.mine()
+	for _, tx := range txs {
+		if _, err := n.WaitMined(ctx, tx); err != nil {
+			t.Fatalf("WaitMined: %v", err)
+		}
+	}
+	if got := chain.nonce(contracts.Exchange, maker); got.Int64() != 3 {
+		t.Errorf("on-chain nonce = %s, want 3", got)
+	}
+	expectNonce(t, "mined", n, false, 3)
+	expectNonce(t, "mined neg risk", n, true, 1)
+}
+
+func TestNonceManagerRevertedIncrement(t *testing.T) {
+	ctx := context.Background()
+	n, chain, _ := newTestNonceManager(t)
+
+	tx, err := n.IncrementNonce(ctx, false)
+	if err != nil {
+		t.Fatalf("IncrementNonce: %v", err)
+	}
+	expectNonce(t, "pending", n, false, 1)
+
+	chain.revert = true
+	chain.mine()
+	if _, err := n.WaitMined(ctx, tx); err == nil || !strings.Contains(err.Error(), "reverted") {
+		t.Errorf("WaitMined error = %v, want a revert", err)
+	}
+	expectNonce(t, "reverted", n, false, 0)
+}
+
+func TestNonceManagerRejectsWalletMakers(t *testing.T) {
+	ctx := context.Background()
+	wallet := "0x70997970C51812dc3A010C7d01b50e0d17dc79C8"
+	n, chain, _ := newTestNonceManager(t, func(config *client.Config) {
+		config.Network = client.AmoyNetwork()
+		config.SignatureType = client.SignatureTypeBrowserWallet
+		config.Funder = wallet
+	})
+
+	// The nonce of the wallet is read, but only the wallet itself can increment it
+	chain.nonces[n.client.Network().Contracts.Exchange][common.HexToAddress(wallet)] = big.NewInt(7)
+	expectNonce(t, "wallet nonce", n, false, 7)
+	_, err := n.IncrementNonce(ctx, false)
+	if err == nil || !strings.Contains(err.Error(), "execute IncrementNonceCalldata") {
+		t.Errorf("IncrementNonce error = %v, want a wallet maker error", err)
+	}
+	if len(chain.pending) != 0 {
+		t.Errorf("%d transactions sent, want 0", len(chain.pending))
+	}
+
+	public, err := client.NewPublicClient(nil)
+	if err != nil {
+		t.Fatalf("NewPublicClient: %v", err)
+	}
+	readOnly := NewNonceManagerWithBackend(public, chain)
+	if _, err := readOnly.Nonce(ctx, false); !errors.Is(err, client.ErrNoSigner) {
+		t.Errorf("Nonce of a read-only client error = %v, want ErrNoSigner", err)
+	}
+	if _, err := readOnly.IncrementNonce(ctx, false); !errors.Is(err, client.ErrNoSigner) {
+		t.Errorf("IncrementNonce of a read-only client error = %v, want ErrNoSigner", err)
+	}
+}
@@ -1,10 +1,12 @@
 package api
 
 import (
-	"encoding/hex"
+	"context"
 	"encoding/json"
 	"fmt"
+	"log/slog"
 	"math/big"
+	"net/http"
 	"net/url"
 	"strconv"
 	"sync"
@@ -13,11 +15,7 @@ import (
 	"github.com/mtt-labs/poly-market-sdk/auth"
 	"github.com/mtt-labs/poly-market-sdk/client"
 	"github.com/mtt-labs/poly-market-sdk/models"
-
-	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/crypto"
-	"github.com/polymarket/go-order-utils/pkg/builder"
-	ordermodel "github.com/polymarket/go-order-utils/pkg/model"
+	"github.com/mtt-labs/poly-market-sdk/orderbuilder"
 )
 
 const COLLATERAL_TOKEN_DECIMALS = 6
@@ -26,10 +24,12 @@ const CONDITIONAL_TOKEN_DECIMALS = 6
 // OrdersAPI provides order-related API methods
 type OrdersAPI struct {
 	client    *client.Client
-	tickSizes map[string]string // Cache for tickSize, key is tokenID
-	feeRates  map[string]int    // Cache for feeRateBps, key is tokenID
-	negRisks  map[string]bool   // Cache for negRisk, key is tokenID
-	mu        sync.RWMutex      // RWMutex to protect caches
+	tickSizes map[string]string             // Cache for tickSize, key is tokenID
+	feeRates  map[string]int                // Cache for feeRateBps, key is tokenID
+	negRisks  map[string]bool               // Cache for negRisk, key is tokenID
+	markets   map[string]*marketConstraints // Cache for market constraints checked by validation, key is tokenID
+	mu        sync.RWMutex                  // RWMutex to protect caches
+	nonces    *NonceManager                 // Exchange nonces of signed orders (optional, nonce 0 without it)
 }
 
 // NewOrdersAPI creates a new OrdersAPI instance
@@ -39,32 +39,16 @@ func NewOrdersAPI(c *client.Client) *OrdersAPI {
 		tickSizes: make(map[string]string),
 		feeRates:  make(map[string]int),
 		negRisks:  make(map[string]bool),
+		markets:   make(map[string]*marketConstraints),
 	}
 }
 
-// generateL2Headers generates L2 authentication headers (helper function)
-func (o *OrdersAPI) generateL2Headers(method, path, body string) (*auth.L2AuthHeaders, error) {
-	apiKey := o.client.GetAPIKey()
-	if apiKey == "" {
-		return nil, fmt.Errorf("API key is required")
-	}
-
-	secret := o.client.GetAPISecret()
-	passphrase := o.client.GetAPIPassphrase()
-	if secret == "" || passphrase == "" {
-		return nil, fmt.Errorf("API secret and passphrase are required")
-	}
-
-	address := o.client.GetAddress()
-	timestamp := time.Now().Unix()
-
-	signer := o.client.GetSigner()
-	l2Headers, err := signer.SignL2Auth(address, method, path, body, timestamp, apiKey, secret, passphrase)
-	if err != nil {
-		return nil, fmt.Errorf("sign L2 auth: %w", err)
-	}
-
-	return l2Headers, nil
+// SetNonceManager signs orders with the maker's current exchange nonce read by n, nil signs with nonce 0
+// After the nonce is incremented on-chain, orders signed with the previous nonce are invalid
+func (o *OrdersAPI) SetNonceManager(n *NonceManager) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.nonces = n
 }
 
 // CreateOrder creates and submits an order (according to Polymarket CLOB API documentation)
@@ -76,79 +60,217 @@ func (o *OrdersAPI) generateL2Headers(method, path, body string) (*auth.L2AuthHe
 //   - orderType: Order type (FOK, GTC, GTD, FAK)
 //   - apiKey: API key of the order owner (if empty, will use the API key from client config)
 func (o *OrdersAPI) CreateOrder(signedOrder *models.SignedOrder, orderType models.OrderType, apiKey string) (*models.CreateOrderResponse, error) {
+	return o.CreateOrderCtx(context.Background(), signedOrder, orderType, apiKey)
+}
+
+// CreateOrderCtx is like CreateOrder but bound to ctx
+func (o *OrdersAPI) CreateOrderCtx(ctx context.Context, signedOrder *models.SignedOrder, orderType models.OrderType, apiKey string) (*models.CreateOrderResponse, error) {
+	return o.postOrder(ctx, signedOrder, orderType, apiKey, false)
+}
+
+// CreatePostOnlyOrder is like CreateOrder but the order is post-only (maker-only):
+// the CLOB rejects it instead of matching it against resting orders on arrival
+// Only GTC and GTD orders can be post-only
+// Reference: https://docs.polymarket.com/developers/CLOB/orders/create-order
+func (o *OrdersAPI) CreatePostOnlyOrder(signedOrder *models.SignedOrder, orderType models.OrderType, apiKey string) (*models.CreateOrderResponse, error) {
+	return o.CreatePostOnlyOrderCtx(context.Background(), signedOrder, orderType, apiKey)
+}
+
+// CreatePostOnlyOrderCtx is like CreatePostOnlyOrder but bound to ctx
+func (o *OrdersAPI) CreatePostOnlyOrderCtx(ctx context.Context, signedOrder *models.SignedOrder, orderType models.OrderType, apiKey string) (*models.CreateOrderResponse, error) {
+	if err := validatePostOnly(orderType); err != nil {
+		return nil, err
+	}
+	return o.postOrder(ctx, signedOrder, orderType, apiKey, true)
+}
+
+// postOrder posts a signed order and records the placement metrics
+func (o *OrdersAPI) postOrder(ctx context.Context, signedOrder *models.SignedOrder, orderType models.OrderType, apiKey string, postOnly bool) (*models.CreateOrderResponse, error) {
+	response, err := o.createOrder(ctx, signedOrder, orderType, apiKey, postOnly)
+	if err != nil && signedOrder != nil {
+		o.forgetRejectedMarket(signedOrder.TokenID, err)
+	}
+
+	status := ""
+	if response != nil {
+		status = response.Status
+	}
+	o.client.Telemetry().RecordOrderPlacement(ctx, string(orderType), status, err)
+
+	return response, err
+}
+
+// createOrder posts a signed order
+func (o *OrdersAPI) createOrder(ctx context.Context, signedOrder *models.SignedOrder, orderType models.OrderType, apiKey string, postOnly bool) (*models.CreateOrderResponse, error) {
 	endpoint := "/order"
 
-	// If apiKey is not provided, try to get it from client config
-	if apiKey == "" {
-		apiKey = o.client.GetAPIKey()
-		if apiKey == "" {
-			return nil, fmt.Errorf("API key is required for creating orders")
-		}
+	if o.client.IsReadOnly() {
+		return nil, client.ErrNoSigner
 	}
 
-	// Check if API secret and passphrase are available
-	secret := o.client.GetAPISecret()
-	passphrase := o.client.GetAPIPassphrase()
-	if secret == "" || passphrase == "" {
-		return nil, fmt.Errorf("API secret and passphrase are required for creating orders")
+	apiKey, err := o.orderOwner(apiKey)
+	if err != nil {
+		return nil, err
 	}
 
 	req := &models.CreateOrderRequest{
 		Order:     signedOrder,
 		Owner:     apiKey,
 		OrderType: orderType,
+		PostOnly:  postOnly,
 	}
 
-	// Serialize request body for signing (use compact format to ensure consistency with sent body)
-	reqBodyBytes, err := json.Marshal(req)
+	// Send request with L2 headers (signed by the client for every attempt)
+	data, err := o.client.DoWithL2Ctx(ctx, http.MethodPost, endpoint, req)
 	if err != nil {
-		return nil, fmt.Errorf("marshal request: %w", err)
+		return nil, fmt.Errorf("create order: %w", err)
 	}
-	reqBodyStr := string(reqBodyBytes)
 
-	// Generate L2 headers (use helper function to ensure consistency)
-	l2Headers, err := o.generateL2Headers("POST", endpoint, reqBodyStr)
-	if err != nil {
-		return nil, fmt.Errorf("generate L2 headers: %w", err)
+	var response models.CreateOrderResponse
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("unmarshal response: %w", err)
 	}
 
-	// Send request with L2 headers
-	data, err := o.client.PostWithL2(endpoint, req, l2Headers)
+	// An error message or success false is a rejection, possibly without message
+	// Wrap it as an APIError carrying the raw body so callers can classify it (e.g. client.IsInsufficientBalance)
+	if response.ErrorMsg != "" || !response.Success {
+		apiErr := client.NewAPIError(http.MethodPost, endpoint, http.StatusOK, data)
+		return &response, fmt.Errorf("order placement error: %w", apiErr)
+	}
+
+	return &response, nil
+}
+
+// orderOwner returns the API key owning posted orders, default the client's API key
+func (o *OrdersAPI) orderOwner(apiKey string) (string, error) {
+	// Read the credentials once, they may be swapped concurrently
+	creds := o.client.APICredentials()
+
+	// If apiKey is not provided, try to get it from client config
+	if apiKey == "" {
+		apiKey = creds.Key
+		if apiKey == "" {
+			return "", fmt.Errorf("API key is required for creating orders")
+		}
+	}
+
+	// Check if API secret and passphrase are available
+	if creds.Secret == "" || creds.Passphrase == "" {
+		return "", fmt.Errorf("API secret and passphrase are required for creating orders")
+	}
+	return apiKey, nil
+}
+
+// MaxBatchOrders maximum number of orders the CLOB accepts in a batch request
+// Reference: https://docs.polymarket.com/developers/CLOB/orders/create-order-batch
+const MaxBatchOrders = 15
+
+// PostOrders submits signed orders through the batch endpoint, in chunks of MaxBatchOrders
+// with one L2 signature and round trip per chunk. The results are aligned to orders: each carries
+// the CLOB response of its order and an error when the order was rejected or its chunk failed.
+// The returned error is only set when no order could be posted (missing credentials, invalid input).
+// This endpoint requires L2 Header (API key)
+// Reference: https://docs.polymarket.com/developers/CLOB/orders/create-order-batch
+func (o *OrdersAPI) PostOrders(orders []*models.PostOrderArgs, apiKey string) ([]models.PostOrderResult, error) {
+	return o.PostOrdersCtx(context.Background(), orders, apiKey)
+}
+
+// PostOrdersCtx is like PostOrders but bound to ctx
+func (o *OrdersAPI) PostOrdersCtx(ctx context.Context, orders []*models.PostOrderArgs, apiKey string) ([]models.PostOrderResult, error) {
+	if o.client.IsReadOnly() {
+		return nil, client.ErrNoSigner
+	}
+
+	apiKey, err := o.orderOwner(apiKey)
 	if err != nil {
-		return nil, fmt.Errorf("create order: %w", err)
+		return nil, err
 	}
 
-	var response models.CreateOrderResponse
-	if err := json.Unmarshal(data, &response); err != nil {
-		return nil, fmt.Errorf("unmarshal response: %w", err)
+	requests := make([]*models.CreateOrderRequest, len(orders))
+	for i, args := range orders {
+		if args == nil || args.Order == nil {
+			return nil, fmt.Errorf("order %d: signed order is required", i)
+		}
+		if args.PostOnly {
+			if err := validatePostOnly(args.OrderType); err != nil {
+				return nil, fmt.Errorf("order %d: %w", i, err)
+			}
+		}
+		requests[i] = &models.CreateOrderRequest{
+			Order:     args.Order,
+			Owner:     apiKey,
+			OrderType: args.OrderType,
+			PostOnly:  args.PostOnly,
+		}
 	}
 
-	// Check if there is an error message
-	if response.ErrorMsg != "" {
-		return &response, fmt.Errorf("order placement error: %s", response.ErrorMsg)
+	results := make([]models.PostOrderResult, len(orders))
+	for start := 0; start < len(requests); start += MaxBatchOrders {
+		end := min(start+MaxBatchOrders, len(requests))
+		o.postOrderChunk(ctx, requests[start:end], results[start:end])
 	}
+	return results, nil
+}
+
+// postOrderChunk posts a chunk of orders and fills the results of the chunk
+func (o *OrdersAPI) postOrderChunk(ctx context.Context, requests []*models.CreateOrderRequest, results []models.PostOrderResult) {
+	endpoint := "/orders"
 
-	// If success is false, it indicates a server-side error
-	if !response.Success {
-		return &response, fmt.Errorf("server error: %s", response.ErrorMsg)
+	responses, err := o.postOrderBatch(ctx, endpoint, requests)
+	for i, req := range requests {
+		if err != nil {
+			results[i].Err = err
+		} else {
+			response := &responses[i]
+			results[i].CreateOrderResponse = response
+
+			// Wrap rejections as APIErrors so callers can classify them (e.g. client.IsInsufficientBalance)
+			if response.ErrorMsg != "" || !response.Success {
+				body, _ := json.Marshal(response)
+				apiErr := client.NewAPIError(http.MethodPost, endpoint, http.StatusOK, body)
+				results[i].Err = fmt.Errorf("order placement error: %w", apiErr)
+				o.forgetRejectedMarket(req.Order.TokenID, apiErr)
+			}
+		}
+
+		status := ""
+		if results[i].CreateOrderResponse != nil {
+			status = results[i].Status
+		}
+		o.client.Telemetry().RecordOrderPlacement(ctx, string(req.OrderType), status, results[i].Err)
 	}
+}
 
-	return &response, nil
+// postOrderBatch sends a batch request and returns one response per order
+func (o *OrdersAPI) postOrderBatch(ctx context.Context, endpoint string, requests []*models.CreateOrderRequest) ([]models.CreateOrderResponse, error) {
+	// Send request with L2 headers (signed by the client for every attempt)
+	data, err := o.client.DoWithL2Ctx(ctx, http.MethodPost, endpoint, requests)
+	if err != nil {
+		return nil, fmt.Errorf("post orders: %w", err)
+	}
+
+	var responses []models.CreateOrderResponse
+	if err := json.Unmarshal(data, &responses); err != nil {
+		return nil, fmt.Errorf("unmarshal response: %w", err)
+	}
+	if len(responses) != len(requests) {
+		return nil, fmt.Errorf("post orders: got %d responses for %d orders", len(responses), len(requests))
+	}
+	return responses, nil
 }
 
 // GetOrder gets order details
 // This endpoint requires L2 headers
 func (o *OrdersAPI) GetOrder(orderID string) (*models.Order, error) {
-	endpoint := fmt.Sprintf("/orders/%s", orderID)
+	return o.GetOrderCtx(context.Background(), orderID)
+}
 
-	// Generate L2 headers
-	l2Headers, err := o.generateL2Headers("GET", endpoint, "")
-	if err != nil {
-		return nil, fmt.Errorf("generate L2 headers: %w", err)
-	}
+// GetOrderCtx is like GetOrder but bound to ctx
+func (o *OrdersAPI) GetOrderCtx(ctx context.Context, orderID string) (*models.Order, error) {
+	endpoint := fmt.Sprintf("/orders/%s", orderID)
 
 	// Send request with L2 headers
-	data, err := o.client.GetWithL2(endpoint, l2Headers)
+	data, err := o.client.DoWithL2Ctx(ctx, http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, fmt.Errorf("get order: %w", err)
 	}
@@ -172,6 +294,11 @@ type GetActiveOrdersParams struct {
 // This endpoint requires L2 headers
 // Reference: https://docs.polymarket.com/developers/CLOB/orders/get-active-order
 func (o *OrdersAPI) GetActiveOrders(params *GetActiveOrdersParams) (*models.GetActiveOrdersResponse, error) {
+	return o.GetActiveOrdersCtx(context.Background(), params)
+}
+
+// GetActiveOrdersCtx is like GetActiveOrders but bound to ctx
+func (o *OrdersAPI) GetActiveOrdersCtx(ctx context.Context, params *GetActiveOrdersParams) (*models.GetActiveOrdersResponse, error) {
 	endpoint := "/data/orders"
 
 	// Build query parameters
@@ -193,14 +320,8 @@ func (o *OrdersAPI) GetActiveOrders(params *GetActiveOrdersParams) (*models.GetA
 		}
 	}
 
-	// Generate L2 headers
-	l2Headers, err := o.generateL2Headers("GET", endpoint, "")
-	if err != nil {
-		return nil, fmt.Errorf("generate L2 headers: %w", err)
-	}
-
 	// Send request with L2 headers
-	data, err := o.client.GetWithL2(endpoint, l2Headers)
+	data, err := o.client.DoWithL2Ctx(ctx, http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, fmt.Errorf("get active orders: %w", err)
 	}
@@ -218,6 +339,11 @@ func (o *OrdersAPI) GetActiveOrders(params *GetActiveOrdersParams) (*models.GetA
 // This endpoint requires L2 headers
 // Reference: https://docs.polymarket.com/developers/CLOB/orders/cancel-orders
 func (o *OrdersAPI) CancelOrder(orderID string) (*models.CancelOrderResponse, error) {
+	return o.CancelOrderCtx(context.Background(), orderID)
+}
+
+// CancelOrderCtx is like CancelOrder but bound to ctx
+func (o *OrdersAPI) CancelOrderCtx(ctx context.Context, orderID string) (*models.CancelOrderResponse, error) {
 	endpoint := "/order"
 
 	// Build request body with orderID
@@ -225,20 +351,8 @@ func (o *OrdersAPI) CancelOrder(orderID string) (*models.CancelOrderResponse, er
 		"orderID": orderID,
 	}
 
-	// Serialize request body for signing
-	reqBodyBytes, err := json.Marshal(reqBody)
-	if err != nil {
-		return nil, fmt.Errorf("marshal request: %w", err)
-	}
-
-	// Generate L2 headers (DELETE request with body)
-	l2Headers, err := o.generateL2Headers("DELETE", endpoint, string(reqBodyBytes))
-	if err != nil {
-		return nil, fmt.Errorf("generate L2 headers: %w", err)
-	}
-
 	// Send DELETE request with L2 headers
-	data, err := o.client.DeleteWithL2(endpoint, reqBody, l2Headers)
+	data, err := o.client.DoWithL2Ctx(ctx, http.MethodDelete, endpoint, reqBody)
 	if err != nil {
 		return nil, fmt.Errorf("cancel order: %w", err)
 	}
@@ -255,25 +369,18 @@ func (o *OrdersAPI) CancelOrder(orderID string) (*models.CancelOrderResponse, er
 // This endpoint requires L2 headers
 // Reference: https://docs.polymarket.com/developers/CLOB/orders/cancel-orders
 func (o *OrdersAPI) CancelOrders(orderIDs []string) (*models.CancelOrderResponse, error) {
+	return o.CancelOrdersCtx(context.Background(), orderIDs)
+}
+
+// CancelOrdersCtx is like CancelOrders but bound to ctx
+func (o *OrdersAPI) CancelOrdersCtx(ctx context.Context, orderIDs []string) (*models.CancelOrderResponse, error) {
 	endpoint := "/orders"
 
 	// Build request body (array of order IDs)
 	reqBody := orderIDs
 
-	// Serialize request body for signing
-	reqBodyBytes, err := json.Marshal(reqBody)
-	if err != nil {
-		return nil, fmt.Errorf("marshal request: %w", err)
-	}
-
-	// Generate L2 headers (DELETE request with body)
-	l2Headers, err := o.generateL2Headers("DELETE", endpoint, string(reqBodyBytes))
-	if err != nil {
-		return nil, fmt.Errorf("generate L2 headers: %w", err)
-	}
-
 	// Send DELETE request with L2 headers
-	data, err := o.client.DeleteWithL2(endpoint, reqBody, l2Headers)
+	data, err := o.client.DoWithL2Ctx(ctx, http.MethodDelete, endpoint, reqBody)
 	if err != nil {
 		return nil, fmt.Errorf("cancel orders: %w", err)
 	}
@@ -290,16 +397,15 @@ func (o *OrdersAPI) CancelOrders(orderIDs []string) (*models.CancelOrderResponse
 // This endpoint requires L2 headers
 // Reference: https://docs.polymarket.com/developers/CLOB/orders/cancel-orders
 func (o *OrdersAPI) CancelAllOrders() (*models.CancelOrderResponse, error) {
-	endpoint := "/cancel-all"
+	return o.CancelAllOrdersCtx(context.Background())
+}
 
-	// Generate L2 headers (DELETE request with empty body)
-	l2Headers, err := o.generateL2Headers("DELETE", endpoint, "")
-	if err != nil {
-		return nil, fmt.Errorf("generate L2 headers: %w", err)
-	}
+// CancelAllOrdersCtx is like CancelAllOrders but bound to ctx
+func (o *OrdersAPI) CancelAllOrdersCtx(ctx context.Context) (*models.CancelOrderResponse, error) {
+	endpoint := "/cancel-all"
 
 	// Send DELETE request with L2 headers
-	data, err := o.client.DeleteWithL2(endpoint, nil, l2Headers)
+	data, err := o.client.DoWithL2Ctx(ctx, http.MethodDelete, endpoint, nil)
 	if err != nil {
 		return nil, fmt.Errorf("cancel all orders: %w", err)
 	}
@@ -316,6 +422,11 @@ func (o *OrdersAPI) CancelAllOrders() (*models.CancelOrderResponse, error) {
 // This endpoint requires L2 headers
 // Reference: https://docs.polymarket.com/developers/CLOB/orders/cancel-orders
 func (o *OrdersAPI) CancelMarketOrders(params *models.CancelMarketOrdersParams) (*models.CancelOrderResponse, error) {
+	return o.CancelMarketOrdersCtx(context.Background(), params)
+}
+
+// CancelMarketOrdersCtx is like CancelMarketOrders but bound to ctx
+func (o *OrdersAPI) CancelMarketOrdersCtx(ctx context.Context, params *models.CancelMarketOrdersParams) (*models.CancelOrderResponse, error) {
 	endpoint := "/cancel-market-orders"
 
 	// Build request body
@@ -329,28 +440,12 @@ func (o *OrdersAPI) CancelMarketOrders(params *models.CancelMarketOrdersParams)
 		}
 	}
 
-	// Serialize request body for signing
-	var reqBodyBytes []byte
-	var err error
-	if len(reqBody) > 0 {
-		reqBodyBytes, err = json.Marshal(reqBody)
-		if err != nil {
-			return nil, fmt.Errorf("marshal request: %w", err)
-		}
-	}
-
-	// Generate L2 headers (DELETE request with body)
-	l2Headers, err := o.generateL2Headers("DELETE", endpoint, string(reqBodyBytes))
-	if err != nil {
-		return nil, fmt.Errorf("generate L2 headers: %w", err)
-	}
-
 	// Send DELETE request with L2 headers
 	var body interface{}
 	if len(reqBody) > 0 {
 		body = reqBody
 	}
-	data, err := o.client.DeleteWithL2(endpoint, body, l2Headers)
+	data, err := o.client.DoWithL2Ctx(ctx, http.MethodDelete, endpoint, body)
 	if err != nil {
 		return nil, fmt.Errorf("cancel market orders: %w", err)
 	}
@@ -367,6 +462,11 @@ func (o *OrdersAPI) CancelMarketOrders(params *models.CancelMarketOrdersParams)
 // This endpoint requires L2 headers
 // Reference: https://docs.polymarket.com/developers/CLOB/orders/check-scoring
 func (o *OrdersAPI) CheckOrderScoring(orderID string) (*models.OrderScoringResponse, error) {
+	return o.CheckOrderScoringCtx(context.Background(), orderID)
+}
+
+// CheckOrderScoringCtx is like CheckOrderScoring but bound to ctx
+func (o *OrdersAPI) CheckOrderScoringCtx(ctx context.Context, orderID string) (*models.OrderScoringResponse, error) {
 	endpoint := "/order-scoring"
 
 	// Build query parameters
@@ -374,14 +474,8 @@ func (o *OrdersAPI) CheckOrderScoring(orderID string) (*models.OrderScoringRespo
 	queryValues.Set("order_id", orderID)
 	endpoint = endpoint + "?" + queryValues.Encode()
 
-	// Generate L2 headers
-	l2Headers, err := o.generateL2Headers("GET", endpoint, "")
-	if err != nil {
-		return nil, fmt.Errorf("generate L2 headers: %w", err)
-	}
-
 	// Send request with L2 headers
-	data, err := o.client.GetWithL2(endpoint, l2Headers)
+	data, err := o.client.DoWithL2Ctx(ctx, http.MethodGet, endpoint, nil)
 	if err != nil {
 		return nil, fmt.Errorf("check order scoring: %w", err)
 	}
@@ -398,6 +492,11 @@ func (o *OrdersAPI) CheckOrderScoring(orderID string) (*models.OrderScoringRespo
 // This endpoint requires L2 headers
 // Reference: https://docs.polymarket.com/developers/CLOB/orders/check-scoring
 func (o *OrdersAPI) CheckOrdersScoring(orderIDs []string) (models.OrdersScoringResponse, error) {
+	return o.CheckOrdersScoringCtx(context.Background(), orderIDs)
+}
+
+// CheckOrdersScoringCtx is like CheckOrdersScoring but bound to ctx
+func (o *OrdersAPI) CheckOrdersScoringCtx(ctx context.Context, orderIDs []string) (models.OrdersScoringResponse, error) {
 	endpoint := "/orders-scoring"
 
 	// Build request body
@@ -405,20 +504,8 @@ func (o *OrdersAPI) CheckOrdersScoring(orderIDs []string) (models.OrdersScoringR
 		"orderIds": orderIDs,
 	}
 
-	// Serialize request body for signing
-	reqBodyBytes, err := json.Marshal(reqBody)
-	if err != nil {
-		return nil, fmt.Errorf("marshal request: %w", err)
-	}
-
-	// Generate L2 headers
-	l2Headers, err := o.generateL2Headers("POST", endpoint, string(reqBodyBytes))
-	if err != nil {
-		return nil, fmt.Errorf("generate L2 headers: %w", err)
-	}
-
 	// Send request with L2 headers
-	data, err := o.client.PostWithL2(endpoint, reqBody, l2Headers)
+	data, err := o.client.DoWithL2Ctx(ctx, http.MethodPost, endpoint, reqBody)
 	if err != nil {
 		return nil, fmt.Errorf("check orders scoring: %w", err)
 	}
@@ -438,6 +525,16 @@ func (o *OrdersAPI) CreateAndPostOrder(
 	params *models.CreateAndPostOrderParams,
 	config *models.CreateAndPostOrderConfig,
 	orderType models.OrderType,
+) (*models.CreateOrderResponse, error) {
+	return o.CreateAndPostOrderCtx(context.Background(), params, config, orderType)
+}
+
+// CreateAndPostOrderCtx is like CreateAndPostOrder but bound to ctx
+func (o *OrdersAPI) CreateAndPostOrderCtx(
+	ctx context.Context,
+	params *models.CreateAndPostOrderParams,
+	config *models.CreateAndPostOrderConfig,
+	orderType models.OrderType,
 ) (*models.CreateOrderResponse, error) {
 	if params == nil {
 		return nil, fmt.Errorf("params is required")
@@ -446,156 +543,379 @@ func (o *OrdersAPI) CreateAndPostOrder(
 		return nil, fmt.Errorf("config is required")
 	}
 
-	// Get private key
-	privateKeyHex := o.client.GetPrivateKey()
-	if privateKeyHex == "" {
-		return nil, fmt.Errorf("private key is required")
+	if config.PostOnly {
+		if err := validatePostOnly(orderType); err != nil {
+			return nil, err
+		}
 	}
 
-	privateKey, err := crypto.HexToECDSA(privateKeyHex)
+	// Orders are signed by the client's signer (private key, keystore or external signer)
+	signer, err := o.client.RequireSigner()
 	if err != nil {
-		return nil, fmt.Errorf("invalid private key: %w", err)
+		return nil, err
 	}
 
-	// Get address and configuration
-	address := o.client.GetAddress()
-	chainID := o.client.GetChainID()
-	funder := o.client.GetFunder()
-	if funder == "" {
-		funder = address // If no funder, use address
+	// Validate the order against the market constraints before signing, tickSize is fetched if not provided
+	tickSize, err := o.validateOrder(ctx, params, config, orderType)
+	if err != nil {
+		return nil, err
+	}
+	roundingConfig, err := models.RoundingConfigForTickSize(tickSize)
+	if err != nil {
+		return nil, err
 	}
 
-	signatureTypeInt := int(o.client.GetSignatureType())
-	if signatureTypeInt == 0 {
-		signatureTypeInt = int(client.SignatureTypeEOA)
+	// Round the size and USDC amount like the official clients, amounts are exact decimals
+	amounts := models.LimitOrderAmounts(models.OrderSide(params.Side), params.Price, params.Size, roundingConfig)
+
+	// Only GTD (Good-Til-Date) orders carry an expiration, others use 0
+	var expiration int64
+	if orderType == models.OrderTypeGTD {
+		expiration = config.Expiration.Unix()
+	}
+
+	signedOrder, err := o.signOrder(ctx, signer, &orderSpec{
+		tokenID:    params.TokenID,
+		side:       params.Side,
+		amounts:    amounts,
+		expiration: expiration,
+		negRisk:    config.NegRisk,
+		nonce:      config.Nonce,
+		orderType:  orderType,
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// Get tickSize (if not provided, fetch from API)
-	tickSizeStr := config.TickSize
-	if tickSizeStr == "" {
-		var err error
-		tickSizeStr, err = o.GetTickSize(params.TokenID)
-		if err != nil {
-			return nil, fmt.Errorf("get tick size: %w", err)
-		}
+	// Submit the order
+	return o.postOrder(ctx, signedOrder, orderType, "", config.PostOnly)
+}
+
+// CreateAndPostMarketOrder creates and posts a market order (similar to createAndPostMarketOrder in TypeScript client)
+// A buy spends params.Amount USDC and a sell sells params.Amount shares. Unless params.Price is set, the
+// worst acceptable price is computed by walking the current orderbook. orderType is FOK (default) or FAK:
+// a FOK order fails with models.ErrInsufficientLiquidity when the orderbook cannot fill the whole amount.
+// The response carries the quote (expected average fill price) the order was built from.
+// The order is checked by ValidateMarketOrder before it is quoted.
+// Reference: https://github.com/Polymarket/clob-client
+func (o *OrdersAPI) CreateAndPostMarketOrder(
+	params *models.CreateMarketOrderParams,
+	config *models.CreateAndPostOrderConfig,
+	orderType models.OrderType,
+) (*models.CreateMarketOrderResponse, error) {
+	return o.CreateAndPostMarketOrderCtx(context.Background(), params, config, orderType)
+}
+
+// CreateAndPostMarketOrderCtx is like CreateAndPostMarketOrder but bound to ctx
+func (o *OrdersAPI) CreateAndPostMarketOrderCtx(
+	ctx context.Context,
+	params *models.CreateMarketOrderParams,
+	config *models.CreateAndPostOrderConfig,
+	orderType models.OrderType,
+) (*models.CreateMarketOrderResponse, error) {
+	if params == nil {
+		return nil, fmt.Errorf("params is required")
+	}
+	if config == nil {
+		return nil, fmt.Errorf("config is required")
+	}
+	if config.PostOnly {
+		return nil, fmt.Errorf("market orders cannot be post-only")
+	}
+	if !config.Expiration.IsZero() {
+		return nil, fmt.Errorf("market orders cannot have an expiration")
 	}
 
-	// Parse tickSize
-	tickSize, err := strconv.ParseFloat(tickSizeStr, 64)
+	signer, err := o.client.RequireSigner()
 	if err != nil {
-		return nil, fmt.Errorf("invalid tickSize: %w", err)
+		return nil, err
 	}
 
-	// Calculate price (round to tickSize)
-	roundedPrice := roundToTickSize(params.Price, tickSize)
+	// Validate the order against the market constraints before quoting, tickSize is fetched if not provided
+	tickSize, err := o.validateMarketOrder(ctx, params, config)
+	if err != nil {
+		return nil, err
+	}
+	roundingConfig, err := models.RoundingConfigForTickSize(tickSize)
+	if err != nil {
+		return nil, err
+	}
 
-	var makerAmount, takerAmount *big.Int
-	if params.Side == 0 { // BUY
-		// makerAmount = price * size (USDC, 6 decimals)
-		// Use big.Float for precise calculation
-		makerAmountFloat := big.NewFloat(roundedPrice * params.Size)
-		makerAmountFloat.Mul(makerAmountFloat, big.NewFloat(1e6)) // USDC has 6 decimals
-		makerAmount, _ = makerAmountFloat.Int(nil)
-		// takerAmount = size (tokens, 6 decimals)
-		takerAmountFloat := big.NewFloat(params.Size)
-		takerAmountFloat.Mul(takerAmountFloat, big.NewFloat(1e6)) // Tokens have 6 decimals
-		takerAmount, _ = takerAmountFloat.Int(nil)
-	} else { // SELL
-		// makerAmount = size (tokens, 6 decimals)
-		makerAmountFloat := big.NewFloat(params.Size)
-		makerAmountFloat.Mul(makerAmountFloat, big.NewFloat(1e6)) // Tokens have 6 decimals
-		makerAmount, _ = makerAmountFloat.Int(nil)
-		// takerAmount = price * size (USDC, 6 decimals)
-		takerAmountFloat := big.NewFloat(roundedPrice * params.Size)
-		takerAmountFloat.Mul(takerAmountFloat, big.NewFloat(1e6)) // USDC has 6 decimals
-		takerAmount, _ = takerAmountFloat.Int(nil)
+	quote, err := o.QuoteMarketOrderCtx(ctx, params, orderType)
+	if err != nil {
+		return nil, err
+	}
+	if orderType == "" {
+		orderType = models.OrderTypeFOK
+	}
+	amounts, err := models.MarketOrderAmounts(models.OrderSide(params.Side), params.Amount, quote.Price, roundingConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	o.client.Logger().LogAttrs(ctx, slog.LevelDebug, "polymarket market order quoted",
+		slog.String("token_id", params.TokenID),
+		slog.String("amount", params.Amount.String()),
+		slog.String("price", quote.Price.String()),
+		slog.String("average_price", quote.AveragePrice.String()),
+		slog.String("shares", quote.Shares.String()),
+		slog.Bool("fully_filled", quote.FullyFilled),
+	)
+
+	signedOrder, err := o.signOrder(ctx, signer, &orderSpec{
+		tokenID:   params.TokenID,
+		side:      params.Side,
+		amounts:   amounts,
+		negRisk:   config.NegRisk,
+		nonce:     config.Nonce,
+		orderType: orderType,
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// Get nonce (default 0, should actually be fetched from API)
-	nonce := big.NewInt(0)
+	response, err := o.CreateOrderCtx(ctx, signedOrder, orderType, "")
+	if err != nil {
+		return nil, err
+	}
+	return &models.CreateMarketOrderResponse{CreateOrderResponse: response, Quote: quote}, nil
+}
 
-	// Get feeRateBps from API
-	feeRateBpsInt, err := o.GetFeeRateBps(params.TokenID)
+// QuoteMarketOrder walks the current orderbook to quote a market order without submitting it
+// The quote reports the worst price reached and the expected average fill price. A non-zero params.Price
+// caps the walk at that price, so passing quote.Price on submission keeps the order at the quoted limit.
+// Reference: https://github.com/Polymarket/clob-client
+func (o *OrdersAPI) QuoteMarketOrder(params *models.CreateMarketOrderParams, orderType models.OrderType) (*models.MarketOrderQuote, error) {
+	return o.QuoteMarketOrderCtx(context.Background(), params, orderType)
+}
+
+// QuoteMarketOrderCtx is like QuoteMarketOrder but bound to ctx
+func (o *OrdersAPI) QuoteMarketOrderCtx(ctx context.Context, params *models.CreateMarketOrderParams, orderType models.OrderType) (*models.MarketOrderQuote, error) {
+	if params == nil {
+		return nil, fmt.Errorf("params is required")
+	}
+	switch orderType {
+	case "", models.OrderTypeFOK, models.OrderTypeFAK:
+	default:
+		return nil, fmt.Errorf("market orders must be FOK or FAK, got %s", orderType)
+	}
+
+	book, err := NewMarketDataAPI(o.client).GetOrderBookCtx(ctx, params.TokenID)
 	if err != nil {
-		return nil, fmt.Errorf("get fee rate bps: %w", err)
+		return nil, err
 	}
-	feeRateBps := big.NewInt(int64(feeRateBpsInt))
 
-	// Set expiration time
-	// Only GTD (Good-Til-Date) orders need expiration, others should be 0
-	var expiration int64
-	if orderType == models.OrderTypeGTD {
-		expiration = time.Now().Add(30 * 24 * time.Hour).Unix()
-	} else {
-		expiration = 0
+	quote, err := models.QuoteMarketOrder(book, models.OrderSide(params.Side), params.Amount, params.Price)
+	if err != nil {
+		return nil, err
 	}
+	if !quote.FullyFilled && orderType != models.OrderTypeFAK {
+		return nil, fmt.Errorf("FOK market order of %s: only %s fillable: %w", params.Amount, fillable(quote, params.Side), models.ErrInsufficientLiquidity)
+	}
+	return quote, nil
+}
 
-	// Get negRisk (if not provided, fetch from API)
-	negRisk := config.NegRisk
-	if negRisk == nil {
-		var err error
-		negRiskValue, err := o.GetNegRisk(params.TokenID)
+// MinGTDExpirationLeadTime security threshold of the CLOB: a GTD order must expire more than this after the
+// server time, an order meant to live for 30 seconds expires at now + 1 minute + 30 seconds
+// Reference: https://docs.polymarket.com/developers/CLOB/orders/create-order
+const MinGTDExpirationLeadTime = time.Minute
+
+// validatePostOnly checks that an order type can be post-only
+func validatePostOnly(orderType models.OrderType) error {
+	if orderType != models.OrderTypeGTC && orderType != models.OrderTypeGTD {
+		return fmt.Errorf("post-only orders must be GTC or GTD, got %s", orderType)
+	}
+	return nil
+}
+
+// marketStatusTTL how long the cached status of a market accepting orders is trusted
+const marketStatusTTL = 30 * time.Second
+
+// marketConstraints constraints of a market checked by order validation
+type marketConstraints struct {
+	minSize         *models.Decimal // Minimum order size, nil when unknown
+	acceptingOrders bool
+	closed          bool
+	endDate         *time.Time // End date, nil when unknown
+	fetchedAt       time.Time  // When the market was fetched
+}
+
+// fresh reports whether the cached constraints can be used: the market accepts orders and its status
+// was fetched within marketStatusTTL. A paused or closed market is always fetched again.
+func (c *marketConstraints) fresh() bool {
+	return c.acceptingOrders && !c.closed && time.Since(c.fetchedAt) < marketStatusTTL
+}
+
+// marketConstraints gets the constraints of the Gamma market of a CLOB token (with cache)
+func (o *OrdersAPI) marketConstraints(ctx context.Context, tokenID string) (*marketConstraints, error) {
+	// First check cache
+	o.mu.RLock()
+	if market, exists := o.markets[tokenID]; exists && market.fresh() {
+		o.mu.RUnlock()
+		return market, nil
+	}
+	o.mu.RUnlock()
+
+	// Cache miss, fetch from the Gamma API
+	markets, err := NewMarketsAPI(o.client).GetMarketsCtx(ctx, &ListMarketsParams{ClobTokenIDs: []string{tokenID}})
+	if err != nil {
+		return nil, err
+	}
+	if len(markets) == 0 {
+		return nil, fmt.Errorf("no market found for token %s", tokenID)
+	}
+
+	market := &markets[0]
+	constraints := &marketConstraints{
+		acceptingOrders: market.AcceptingOrders == nil || *market.AcceptingOrders,
+		closed:          market.Closed != nil && *market.Closed,
+		endDate:         market.EndDate,
+		fetchedAt:       time.Now(),
+	}
+	if market.OrderMinSize != nil {
+		minSize, err := models.NewDecimalFromFloat(*market.OrderMinSize)
 		if err != nil {
-			return nil, fmt.Errorf("get neg risk: %w", err)
+			return nil, fmt.Errorf("market minimum order size: %w", err)
 		}
-		negRisk = &negRiskValue
+		constraints.minSize = &minSize
+	}
+
+	// Store result in cache
+	o.mu.Lock()
+	o.markets[tokenID] = constraints
+	o.mu.Unlock()
+
+	return constraints, nil
+}
+
+// InvalidateMarketCache drops the cached tick size, fee rate, neg risk and market constraints of a token, an
+// empty tokenID drops every token. The next order of the token fetches them again. Call it when a market
+// changes, e.g. on a tick_size_change event. Orders rejected for the state or tick size of their market
+// drop it automatically.
+func (o *OrdersAPI) InvalidateMarketCache(tokenID string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if tokenID == "" {
+		clear(o.tickSizes)
+		clear(o.feeRates)
+		clear(o.negRisks)
+		clear(o.markets)
+		return
+	}
+	delete(o.tickSizes, tokenID)
+	delete(o.feeRates, tokenID)
+	delete(o.negRisks, tokenID)
+	delete(o.markets, tokenID)
+}
+
+// forgetRejectedMarket drops the cached market of a token whose order was rejected for the state or
+// tick size of the market, so the next order of the token sees the change
+func (o *OrdersAPI) forgetRejectedMarket(tokenID string, err error) {
+	if client.IsMarketUnavailable(err) || client.IsTickSizeViolation(err) {
+		o.InvalidateMarketCache(tokenID)
 	}
+}
 
-	// Determine which contract to use
-	var contract ordermodel.VerifyingContract
-	if *negRisk {
-		contract = ordermodel.NegRiskCTFExchange
-	} else {
-		contract = ordermodel.CTFExchange
+// fillable returns the fillable amount of a quote, in USDC for a buy and in shares for a sell
+func fillable(quote *models.MarketOrderQuote, side int) models.Decimal {
+	if models.OrderSide(side) == models.OrderSideBuy {
+		return quote.Notional
 	}
+	return quote.Shares
+}
+
+// orderSpec order to build and sign, amounts are already rounded
+type orderSpec struct {
+	tokenID    string
+	side       int
+	amounts    *models.OrderAmounts
+	expiration int64
+	negRisk    *bool    // nil fetches it from the API
+	nonce      *big.Int // nil uses the nonce manager
+	orderType  models.OrderType
+}
 
-	// Build OrderData
-	orderData := &ordermodel.OrderData{
-		Maker:         funder,
-		Taker:         common.HexToAddress("0x0000000000000000000000000000000000000000").Hex(), // Public order
-		TokenId:       params.TokenID,
-		MakerAmount:   makerAmount.String(),
-		TakerAmount:   takerAmount.String(),
-		FeeRateBps:    feeRateBps.String(),
-		Nonce:         nonce.String(),
-		Expiration:    strconv.FormatInt(expiration, 10),
-		Side:          params.Side,
-		SignatureType: signatureTypeInt,
-		Signer:        address, // Signer address
+// orderNonce returns the exchange nonce to sign an order with
+func (o *OrdersAPI) orderNonce(ctx context.Context, nonce *big.Int, negRisk bool) (*big.Int, error) {
+	if nonce != nil {
+		return nonce, nil
 	}
 
-	// Use go-order-utils to build and sign order
-	chainIDBig := big.NewInt(int64(chainID))
-	orderBuilder := builder.NewExchangeOrderBuilderImpl(chainIDBig, nil)
-	signedOrder, err := orderBuilder.BuildSignedOrder(privateKey, orderData, contract)
-	if err != nil {
-		return nil, fmt.Errorf("build signed order: %w", err)
+	o.mu.RLock()
+	nonces := o.nonces
+	o.mu.RUnlock()
+	if nonces == nil {
+		return big.NewInt(0), nil
 	}
 
-	// Convert to our SignedOrder format
-	ourSignedOrder := &models.SignedOrder{
-		Salt:          signedOrder.Salt.Int64(),
-		Maker:         signedOrder.Maker.Hex(),
-		Signer:        signedOrder.Signer.Hex(),
-		Taker:         signedOrder.Taker.Hex(),
-		TokenID:       signedOrder.TokenId.String(),
-		MakerAmount:   signedOrder.MakerAmount.String(),
-		TakerAmount:   signedOrder.TakerAmount.String(),
-		Expiration:    signedOrder.Expiration.String(),
-		Nonce:         signedOrder.Nonce.String(),
-		FeeRateBps:    signedOrder.FeeRateBps.String(),
-		Side:          strconv.FormatInt(int64(params.Side), 10),
-		SignatureType: signatureTypeInt,
-		Signature:     "0x" + hex.EncodeToString(signedOrder.Signature),
+	nonce, err := nonces.Nonce(ctx, negRisk)
+	if err != nil {
+		return nil, fmt.Errorf("get exchange nonce: %w", err)
 	}
-	fmt.Println(ourSignedOrder)
-	// Call CreateOrder to submit order
-	return o.CreateOrder(ourSignedOrder, orderType, "")
+	return nonce, nil
 }
 
-// roundToTickSize rounds price to the specified tickSize
-func roundToTickSize(price, tickSize float64) float64 {
-	return float64(int64(price/tickSize+0.5)) * tickSize
+// signOrder fetches the market parameters of an order and signs it offline with orderbuilder
+func (o *OrdersAPI) signOrder(ctx context.Context, signer auth.Signer, spec *orderSpec) (*models.SignedOrder, error) {
+	orderBuilder, err := orderbuilder.NewBuilderWithContracts(o.client.GetChainID(), o.client.Network().Contracts, signer, models.SignatureType(o.client.GetSignatureType()), o.client.GetFunder())
+	if err != nil {
+		return nil, err
+	}
+
+	// Get feeRateBps from API
+	feeRateBps, err := o.GetFeeRateBpsCtx(ctx, spec.tokenID)
+	if err != nil {
+		return nil, fmt.Errorf("get fee rate bps: %w", err)
+	}
+
+	// Get negRisk (if not provided, fetch from API)
+	negRisk := spec.negRisk
+	if negRisk == nil {
+		negRiskValue, err := o.GetNegRiskCtx(ctx, spec.tokenID)
+		if err != nil {
+			return nil, fmt.Errorf("get neg risk: %w", err)
+		}
+		negRisk = &negRiskValue
+	}
+
+	// Get nonce: explicit, read by the nonce manager, or 0
+	nonce, err := o.orderNonce(ctx, spec.nonce, *negRisk)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := orderBuilder.SignCtx(ctx, &orderbuilder.OrderArgs{
+		TokenID: spec.tokenID,
+		Side:    models.OrderSide(spec.side),
+		Market: orderbuilder.MarketParams{
+			NegRisk:    *negRisk,
+			FeeRateBps: feeRateBps,
+		},
+		Nonce:      nonce,
+		Expiration: spec.expiration,
+	}, spec.amounts)
+	if err != nil {
+		return nil, err
+	}
+
+	signedOrder := order.SignedOrder
+	o.client.Logger().LogAttrs(ctx, slog.LevelDebug, "polymarket order built",
+		slog.String("token_id", signedOrder.TokenID),
+		slog.String("side", signedOrder.Side),
+		slog.String("price", spec.amounts.Price.String()),
+		slog.String("size", spec.amounts.Size.String()),
+		slog.String("maker", signedOrder.Maker),
+		slog.String("signer", signedOrder.Signer),
+		slog.String("maker_amount", signedOrder.MakerAmount),
+		slog.String("taker_amount", signedOrder.TakerAmount),
+		slog.String("fee_rate_bps", signedOrder.FeeRateBps),
+		slog.String("expiration", signedOrder.Expiration),
+		slog.String("order_type", string(spec.orderType)),
+		slog.Bool("neg_risk", *negRisk),
+		slog.String("order_hash", order.Hash.Hex()),
+	)
+
+	return signedOrder, nil
 }
 
 // GetTickSizeResponse response for getting tickSize
@@ -606,6 +926,11 @@ type GetTickSizeResponse struct {
 // GetTickSize gets tickSize for the specified tokenID (with cache)
 // Reference: https://github.com/Polymarket/clob-client
 func (o *OrdersAPI) GetTickSize(tokenID string) (string, error) {
+	return o.GetTickSizeCtx(context.Background(), tokenID)
+}
+
+// GetTickSizeCtx is like GetTickSize but bound to ctx
+func (o *OrdersAPI) GetTickSizeCtx(ctx context.Context, tokenID string) (string, error) {
 	// First check cache
 	o.mu.RLock()
 	if tickSize, exists := o.tickSizes[tokenID]; exists {
@@ -620,7 +945,7 @@ func (o *OrdersAPI) GetTickSize(tokenID string) (string, error) {
 	queryValues.Set("token_id", tokenID)
 	endpoint = endpoint + "?" + queryValues.Encode()
 
-	data, err := o.client.Get(endpoint)
+	data, err := o.client.GetCtx(ctx, endpoint)
 	if err != nil {
 		return "", fmt.Errorf("get tick size: %w", err)
 	}
@@ -649,6 +974,11 @@ type GetFeeRateBpsResponse struct {
 // GetFeeRateBps gets feeRateBps for the specified tokenID (with cache)
 // Reference: https://github.com/Polymarket/clob-client
 func (o *OrdersAPI) GetFeeRateBps(tokenID string) (int, error) {
+	return o.GetFeeRateBpsCtx(context.Background(), tokenID)
+}
+
+// GetFeeRateBpsCtx is like GetFeeRateBps but bound to ctx
+func (o *OrdersAPI) GetFeeRateBpsCtx(ctx context.Context, tokenID string) (int, error) {
 	// First check cache
 	o.mu.RLock()
 	if feeRate, exists := o.feeRates[tokenID]; exists {
@@ -663,7 +993,7 @@ func (o *OrdersAPI) GetFeeRateBps(tokenID string) (int, error) {
 	queryValues.Set("token_id", tokenID)
 	endpoint = endpoint + "?" + queryValues.Encode()
 
-	data, err := o.client.Get(endpoint)
+	data, err := o.client.GetCtx(ctx, endpoint)
 	if err != nil {
 		return 0, fmt.Errorf("get fee rate bps: %w", err)
 	}
@@ -689,6 +1019,11 @@ type GetNegRiskResponse struct {
 // GetNegRisk gets negRisk for the specified tokenID (with cache)
 // Reference: https://github.com/Polymarket/clob-client
 func (o *OrdersAPI) GetNegRisk(tokenID string) (bool, error) {
+	return o.GetNegRiskCtx(context.Background(), tokenID)
+}
+
+// GetNegRiskCtx is like GetNegRisk but bound to ctx
+func (o *OrdersAPI) GetNegRiskCtx(ctx context.Context, tokenID string) (bool, error) {
 	// First check cache
 	o.mu.RLock()
 	if negRisk, exists := o.negRisks[tokenID]; exists {
@@ -703,7 +1038,7 @@ func (o *OrdersAPI) GetNegRisk(tokenID string) (bool, error) {
 	queryValues.Set("token_id", tokenID)
 	endpoint = endpoint + "?" + queryValues.Encode()
 
-	data, err := o.client.Get(endpoint)
+	data, err := o.client.GetCtx(ctx, endpoint)
 	if err != nil {
 		return false, fmt.Errorf("get neg risk: %w", err)
 	}
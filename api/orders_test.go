@@ -0,0 +1,275 @@
+package api
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/mtt-labs/poly-market-sdk/client"
+	"github.com/mtt-labs/poly-market-sdk/models"
+	"github.com/mtt-labs/poly-market-sdk/orderbuilder"
+
+	"github.com/ethereum/go-ethereum/common"
+	orderconfig "github.com/polymarket/go-order-utils/pkg/config"
+)
+
+const testTokenID = "71321045679252212594626385532706912750332728571942532289631379312455583992563"
+
+func TestSignOrderUsesNetworkContracts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/fee-rate" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		json.NewEncoder(w).Encode(&GetFeeRateBpsResponse{BaseFee: 0})
+	}))
+	defer server.Close()
+
+	network := client.MainnetNetwork()
+	network.Contracts = &orderconfig.Contracts{
+		Exchange:        common.HexToAddress("0x00000000000000000000000000000000000000e1"),
+		NegRiskExchange: common.HexToAddress("0x00000000000000000000000000000000000000e2"),
+	}
+	c := newTestClient(t, server.URL, func(config *client.Config) {
+		config.Network = network
+	})
+	signer, err := c.RequireSigner()
+	if err != nil {
+		t.Fatalf("RequireSigner: %v", err)
+	}
+
+	config, _ := models.RoundingConfigForTickSize("0.01")
+	amounts := models.LimitOrderAmounts(models.OrderSideBuy, models.MustDecimal("0.56"), models.MustDecimal("21.04"), config)
+	for _, negRisk := range []bool{false, true} {
+		order, err := NewOrdersAPI(c).signOrder(context.Background(), signer, &orderSpec{
+			tokenID: testTokenID,
+			side:    int(models.OrderSideBuy),
+			amounts: amounts,
+			negRisk: &negRisk,
+		})
+		if err != nil {
+			t.Fatalf("signOrder: %v", err)
+		}
+		if _, err := orderbuilder.VerifyOrderWithContracts(c.GetChainID(), network.Contracts, order, negRisk); err != nil {
+			t.Errorf("neg risk %v: order not signed for the network exchange: %v", negRisk, err)
+		}
+	}
+}
+
+// batchServer serves /orders, answering each order with its salt as order ID
+type batchServer struct {
+	*httptest.Server
+	mu     sync.Mutex
+	chunks []int // Size of each posted chunk
+
+	failChunk  int    // Chunk answered with a 500 (1-based, 0 for none)
+	shortChunk int    // Chunk answered with one response less than its orders (1-based, 0 for none)
+	reject     string // Salt of an order rejected with an error message
+}
+
+func newBatchServer(t *testing.T) *batchServer {
+	t.Helper()
+	s := &batchServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/orders" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			http.NotFound(w, r)
+			return
+		}
+		var requests []models.CreateOrderRequest
+		if err := json.NewDecoder(r.Body).Decode(&requests); err != nil {
+			t.Errorf("decode orders: %v", err)
+		}
+
+		s.mu.Lock()
+		s.chunks = append(s.chunks, len(requests))
+		chunk := len(s.chunks)
+		s.mu.Unlock()
+
+		if chunk == s.failChunk {
+			http.Error(w, `{"error":"internal error"}`, http.StatusInternalServerError)
+			return
+		}
+		responses := make([]models.CreateOrderResponse, 0, len(requests))
+		for _, req := range requests {
+			salt := req.Order.Salt.String()
+			if salt == s.reject {
+				responses = append(responses, models.CreateOrderResponse{ErrorMsg: "not enough balance / allowance"})
+				continue
+			}
+			responses = append(responses, models.CreateOrderResponse{Success: true, OrderID: salt, Status: "live"})
+		}
+		if chunk == s.shortChunk {
+			responses = responses[:len(responses)-1]
+		}
+		json.NewEncoder(w).Encode(responses)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// batchOrders returns n orders whose salts are their index
+func batchOrders(n int) []*models.PostOrderArgs {
+	orders := make([]*models.PostOrderArgs, n)
+	for i := range orders {
+		orders[i] = &models.PostOrderArgs{
+			Order:     &models.SignedOrder{Salt: big.NewInt(int64(i)), TokenID: testTokenID},
+			OrderType: models.OrderTypeGTC,
+		}
+	}
+	return orders
+}
+
+// newL2OrdersAPI creates the orders API of a client with API credentials, serving every API from baseURL
+func newL2OrdersAPI(t *testing.T, baseURL string) *OrdersAPI {
+	t.Helper()
+	return NewOrdersAPI(newTestClient(t, baseURL, func(c *client.Config) {
+		c.APIKey = "key"
+		c.APISecret = base64.URLEncoding.EncodeToString([]byte("secret"))
+		c.APIPassphrase = "passphrase"
+	}))
+}
+
+func TestPostOrdersChunks(t *testing.T) {
+	tests := []struct {
+		orders int
+		chunks []int
+	}{
+		{1, []int{1}},
+		{15, []int{15}},
+		{16, []int{15, 1}},
+		{31, []int{15, 15, 1}},
+	}
+	for _, tt := range tests {
+		server := newBatchServer(t)
+		results, err := newL2OrdersAPI(t, server.URL).PostOrders(batchOrders(tt.orders), "")
+		if err != nil {
+			t.Fatalf("%d orders: %v", tt.orders, err)
+		}
+		if !slices.Equal(server.chunks, tt.chunks) {
+			t.Errorf("%d orders: chunks %v, want %v", tt.orders, server.chunks, tt.chunks)
+		}
+		if len(results) != tt.orders {
+			t.Fatalf("%d orders: %d results", tt.orders, len(results))
+		}
+		for i, result := range results {
+			if result.Err != nil || result.CreateOrderResponse == nil || result.OrderID != strconv.Itoa(i) {
+				t.Errorf("%d orders: result %d = %+v, want order %d", tt.orders, i, result, i)
+			}
+		}
+	}
+}
+
+func TestPostOrdersAlignsFailures(t *testing.T) {
+	server := newBatchServer(t)
+	server.failChunk = 2
+	server.reject = "20"
+	results, err := newL2OrdersAPI(t, server.URL).PostOrders(batchOrders(31), "")
+	if err != nil {
+		t.Fatalf("PostOrders: %v", err)
+	}
+
+	for i, result := range results {
+		switch {
+		case i >= 15 && i < 30:
+			// The second chunk failed as a whole
+			var apiErr *client.APIError
+			if !errors.As(result.Err, &apiErr) || apiErr.StatusCode != http.StatusInternalServerError || result.CreateOrderResponse != nil {
+				t.Errorf("result %d = %+v, want the failure of its chunk", i, result)
+			}
+		case result.Err != nil || result.OrderID != strconv.Itoa(i):
+			t.Errorf("result %d = %+v, want order %d", i, result, i)
+		}
+	}
+
+	// A rejected order only fails its own result
+	server = newBatchServer(t)
+	server.reject = "3"
+	results, _ = newL2OrdersAPI(t, server.URL).PostOrders(batchOrders(5), "")
+	for i, result := range results {
+		if i == 3 {
+			if !client.IsInsufficientBalance(result.Err) || result.CreateOrderResponse == nil {
+				t.Errorf("rejected result = %+v, want an insufficient balance error", result)
+			}
+		} else if result.Err != nil || result.OrderID != strconv.Itoa(i) {
+			t.Errorf("result %d = %+v, want order %d", i, result, i)
+		}
+	}
+}
+
+func TestPostOrdersShortResponse(t *testing.T) {
+	server := newBatchServer(t)
+	server.shortChunk = 1
+	results, err := newL2OrdersAPI(t, server.URL).PostOrders(batchOrders(16), "")
+	if err != nil {
+		t.Fatalf("PostOrders: %v", err)
+	}
+
+	// Responses can't be matched to the orders of the chunk, every order of the chunk fails
+	for i, result := range results {
+		if i < 15 {
+			if result.Err == nil || result.CreateOrderResponse != nil {
+				t.Errorf("result %d = %+v, want an error", i, result)
+			}
+		} else if result.Err != nil || result.OrderID != strconv.Itoa(i) {
+			t.Errorf("result %d = %+v, want order %d", i, result, i)
+		}
+	}
+}
+
+func TestPostOrdersRejectsInvalidInput(t *testing.T) {
+	server := newBatchServer(t)
+	orders := newL2OrdersAPI(t, server.URL)
+
+	invalid := batchOrders(3)
+	invalid[1].Order = nil
+	if _, err := orders.PostOrders(invalid, ""); err == nil {
+		t.Error("nil order expected an error")
+	}
+	invalid = batchOrders(3)
+	invalid[2].PostOnly, invalid[2].OrderType = true, models.OrderTypeFOK
+	if _, err := orders.PostOrders(invalid, ""); err == nil {
+		t.Error("post-only FOK order expected an error")
+	}
+	if len(server.chunks) != 0 {
+		t.Errorf("invalid input posted %d chunks", len(server.chunks))
+	}
+}
+
+func TestCreateOrderRejection(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string // Expected APIError message
+	}{
+		{"error message", `{"success":false,"errorMsg":"not enough balance / allowance"}`, "not enough balance / allowance"},
+		{"error message with success", `{"success":true,"errorMsg":"order delayed"}`, "order delayed"},
+		{"failure without message", `{"success":false,"orderId":""}`, ""},
+	}
+	for _, tt := range tests {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(tt.body))
+		}))
+		response, err := newL2OrdersAPI(t, server.URL).CreateOrder(&models.SignedOrder{TokenID: testTokenID}, models.OrderTypeGTC, "")
+		server.Close()
+
+		var apiErr *client.APIError
+		if !errors.As(err, &apiErr) {
+			t.Errorf("%s: error = %v, want an *client.APIError", tt.name, err)
+			continue
+		}
+		if apiErr.Message != tt.want || apiErr.Body != tt.body || apiErr.StatusCode != http.StatusOK {
+			t.Errorf("%s: APIError = %+v", tt.name, apiErr)
+		}
+		if response == nil {
+			t.Errorf("%s: no response returned for the rejected order", tt.name)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -20,13 +21,18 @@ type SearchAPI struct {
 // NewSearchAPI creates a new SearchAPI instance
 func NewSearchAPI(c *client.Client) *SearchAPI {
 	return &SearchAPI{
-		gammaClient: client.NewGammaClient(),
+		gammaClient: c.GammaClient(),
 	}
 }
 
 // Search searches for markets, events, and profiles
 // Reference: https://docs.polymarket.com/api-reference/search/search-markets-events-and-profiles
 func (s *SearchAPI) Search(params *models.SearchParams) (*models.SearchResponse, error) {
+	return s.SearchCtx(context.Background(), params)
+}
+
+// SearchCtx is like Search but bound to ctx
+func (s *SearchAPI) SearchCtx(ctx context.Context, params *models.SearchParams) (*models.SearchResponse, error) {
 	if params == nil || params.Q == "" {
 		return nil, fmt.Errorf("search query (q) is required")
 	}
@@ -83,7 +89,7 @@ func (s *SearchAPI) Search(params *models.SearchParams) (*models.SearchResponse,
 
 	endpoint = endpoint + "?" + queryValues.Encode()
 
-	data, err := s.gammaClient.Get(endpoint)
+	data, err := s.gammaClient.GetCtx(ctx, endpoint)
 	if err != nil {
 		return nil, fmt.Errorf("search: %w", err)
 	}
@@ -0,0 +1,228 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mtt-labs/poly-market-sdk/models"
+)
+
+// ValidationRule market constraint checked before an order is signed
+type ValidationRule string
+
+const (
+	ValidationRulePrice           ValidationRule = "price"            // Price within (0, 1)
+	ValidationRuleTickSize        ValidationRule = "tick_size"        // Price aligned to the tick size of the market
+	ValidationRuleSize            ValidationRule = "size"             // Size (amount of a market order) above zero
+	ValidationRuleMinSize         ValidationRule = "min_size"         // Size at or above the minimum order size of the market
+	ValidationRuleAcceptingOrders ValidationRule = "accepting_orders" // Market accepting orders
+	ValidationRuleClosed          ValidationRule = "closed"           // Market not closed
+	ValidationRuleExpiration      ValidationRule = "expiration"       // GTD expiration bounds
+)
+
+// Violation violated rule of an order
+type Violation struct {
+	Rule    ValidationRule // Violated rule
+	Message string         // Details of the violation
+}
+
+// ValidationError is returned when an order violates market constraints, before it is signed and submitted
+// It lists every violated rule, use errors.As to inspect it
+type ValidationError struct {
+	TokenID    string      // Token ID of the order
+	Violations []Violation // Every violated rule
+}
+
+// Error implements the error interface
+func (e *ValidationError) Error() string {
+	messages := make([]string, len(e.Violations))
+	for i, violation := range e.Violations {
+		messages[i] = violation.Message
+	}
+	return fmt.Sprintf("invalid order for token %s: %s", e.TokenID, strings.Join(messages, "; "))
+}
+
+// Violates reports whether the order violates a rule
+func (e *ValidationError) Violates(rule ValidationRule) bool {
+	for _, violation := range e.Violations {
+		if violation.Rule == rule {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateOrder checks a limit order against the constraints of its market without signing it: the price is
+// within (0, 1) and aligned to the tick size (config.TickSize, fetched when empty), the size is positive and at
+// or above the market's minimum order size, the market accepts orders and is not closed, and a GTD expiration is
+// more than MinGTDExpirationLeadTime after the server time and not after the market end date. It returns a
+// *ValidationError listing every violated rule. CreateAndPostOrder validates orders before signing them.
+// Market orders are checked by ValidateMarketOrder.
+func (o *OrdersAPI) ValidateOrder(params *models.CreateAndPostOrderParams, config *models.CreateAndPostOrderConfig, orderType models.OrderType) error {
+	return o.ValidateOrderCtx(context.Background(), params, config, orderType)
+}
+
+// ValidateOrderCtx is like ValidateOrder but bound to ctx
+func (o *OrdersAPI) ValidateOrderCtx(ctx context.Context, params *models.CreateAndPostOrderParams, config *models.CreateAndPostOrderConfig, orderType models.OrderType) error {
+	_, err := o.validateOrder(ctx, params, config, orderType)
+	return err
+}
+
+// ValidateMarketOrder checks a market order against the constraints of its market without quoting or signing it:
+// the amount is positive, a non-zero price is within (0, 1) and aligned to the tick size (config.TickSize,
+// fetched when empty), and the market accepts orders and is not closed. The minimum order size is not checked,
+// the amount of a buy is in USDC and its size is only known once quoted. It returns a *ValidationError listing
+// every violated rule. CreateAndPostMarketOrder validates orders before quoting them.
+func (o *OrdersAPI) ValidateMarketOrder(params *models.CreateMarketOrderParams, config *models.CreateAndPostOrderConfig) error {
+	return o.ValidateMarketOrderCtx(context.Background(), params, config)
+}
+
+// ValidateMarketOrderCtx is like ValidateMarketOrder but bound to ctx
+func (o *OrdersAPI) ValidateMarketOrderCtx(ctx context.Context, params *models.CreateMarketOrderParams, config *models.CreateAndPostOrderConfig) error {
+	_, err := o.validateMarketOrder(ctx, params, config)
+	return err
+}
+
+// validateOrder validates a limit order and returns the tick size of its market
+func (o *OrdersAPI) validateOrder(ctx context.Context, params *models.CreateAndPostOrderParams, config *models.CreateAndPostOrderConfig, orderType models.OrderType) (string, error) {
+	if params == nil {
+		return "", fmt.Errorf("params is required")
+	}
+	if config == nil {
+		return "", fmt.Errorf("config is required")
+	}
+
+	tickSize, roundingConfig, market, err := o.validationInputs(ctx, params.TokenID, config.TickSize)
+	if err != nil {
+		return "", err
+	}
+
+	var v violations
+	v.price(params.Price, tickSize, roundingConfig)
+	v.size("size", params.Size)
+	if market.minSize != nil && params.Size.Cmp(*market.minSize) < 0 {
+		v.add(ValidationRuleMinSize, "size %s is below the minimum order size %s", params.Size, *market.minSize)
+	}
+	v.market(market)
+	if message := o.expirationViolation(ctx, market, orderType, config.Expiration); message != "" {
+		v.add(ValidationRuleExpiration, "%s", message)
+	}
+
+	if len(v) > 0 {
+		return "", &ValidationError{TokenID: params.TokenID, Violations: v}
+	}
+	return tickSize, nil
+}
+
+// validateMarketOrder validates a market order and returns the tick size of its market
+func (o *OrdersAPI) validateMarketOrder(ctx context.Context, params *models.CreateMarketOrderParams, config *models.CreateAndPostOrderConfig) (string, error) {
+	if params == nil {
+		return "", fmt.Errorf("params is required")
+	}
+	if config == nil {
+		return "", fmt.Errorf("config is required")
+	}
+
+	tickSize, roundingConfig, market, err := o.validationInputs(ctx, params.TokenID, config.TickSize)
+	if err != nil {
+		return "", err
+	}
+
+	var v violations
+	v.size("amount", params.Amount)
+	// A zero price is computed from the orderbook, which only holds prices within (0, 1)
+	if !params.Price.IsZero() {
+		v.price(params.Price, tickSize, roundingConfig)
+	}
+	v.market(market)
+
+	if len(v) > 0 {
+		return "", &ValidationError{TokenID: params.TokenID, Violations: v}
+	}
+	return tickSize, nil
+}
+
+// validationInputs returns the tick size (fetched when empty), rounding config and market constraints of a token
+func (o *OrdersAPI) validationInputs(ctx context.Context, tokenID, tickSize string) (string, models.RoundingConfig, *marketConstraints, error) {
+	if tickSize == "" {
+		var err error
+		tickSize, err = o.GetTickSizeCtx(ctx, tokenID)
+		if err != nil {
+			return "", models.RoundingConfig{}, nil, fmt.Errorf("get tick size: %w", err)
+		}
+	}
+	roundingConfig, err := models.RoundingConfigForTickSize(tickSize)
+	if err != nil {
+		return "", models.RoundingConfig{}, nil, err
+	}
+
+	market, err := o.marketConstraints(ctx, tokenID)
+	if err != nil {
+		return "", models.RoundingConfig{}, nil, fmt.Errorf("get market: %w", err)
+	}
+	return tickSize, roundingConfig, market, nil
+}
+
+// violations rules violated by an order
+type violations []Violation
+
+// add records a violated rule
+func (v *violations) add(rule ValidationRule, format string, args ...any) {
+	*v = append(*v, Violation{Rule: rule, Message: fmt.Sprintf(format, args...)})
+}
+
+// price checks that a price is within (0, 1) and aligned to the tick size
+func (v *violations) price(price models.Decimal, tickSize string, roundingConfig models.RoundingConfig) {
+	one := models.NewDecimalFromInt(1)
+	if price.Sign() <= 0 || price.Cmp(one) >= 0 {
+		v.add(ValidationRulePrice, "price %s must be between 0 and 1 (exclusive)", price)
+	}
+	if price.DecimalPlaces() > roundingConfig.Price {
+		v.add(ValidationRuleTickSize, "price %s is not a multiple of the tick size %s", price, tickSize)
+	}
+}
+
+// size checks that a size or amount is positive
+func (v *violations) size(name string, size models.Decimal) {
+	if size.Sign() <= 0 {
+		v.add(ValidationRuleSize, "%s %s must be positive", name, size)
+	}
+}
+
+// market checks that the market accepts orders and is not closed
+func (v *violations) market(market *marketConstraints) {
+	if !market.acceptingOrders {
+		v.add(ValidationRuleAcceptingOrders, "market is not accepting orders")
+	}
+	if market.closed {
+		v.add(ValidationRuleClosed, "market is closed")
+	}
+}
+
+// expirationViolation checks the expiration of an order against the server time and the market end date
+func (o *OrdersAPI) expirationViolation(ctx context.Context, market *marketConstraints, orderType models.OrderType, expiration time.Time) string {
+	if orderType != models.OrderTypeGTD {
+		if !expiration.IsZero() {
+			return fmt.Sprintf("expiration is only supported for GTD orders, got %s", orderType)
+		}
+		return ""
+	}
+	if expiration.IsZero() {
+		return "GTD orders require an expiration"
+	}
+
+	// Checked against the server clock, a drifting local clock could accept an order the CLOB rejects
+	o.client.EnsureTimeSync(ctx)
+	minimum := o.client.Now().Add(MinGTDExpirationLeadTime)
+	if !expiration.After(minimum) {
+		return fmt.Sprintf("GTD expiration %s must be after %s (server time + %s)",
+			expiration.UTC().Format(time.RFC3339), minimum.UTC().Format(time.RFC3339), MinGTDExpirationLeadTime)
+	}
+	if market.endDate != nil && expiration.After(*market.endDate) {
+		return fmt.Sprintf("GTD expiration %s is after the market end date %s",
+			expiration.UTC().Format(time.RFC3339), market.endDate.UTC().Format(time.RFC3339))
+	}
+	return ""
+}
@@ -0,0 +1,280 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/mtt-labs/poly-market-sdk/client"
+	"github.com/mtt-labs/poly-market-sdk/models"
+)
+
+// marketServer serves /tick-size (0.01), the Gamma /markets lookup of tokens and /order, counting the requests per path
+type marketServer struct {
+	*httptest.Server
+	mu            sync.Mutex
+	markets       map[string]*models.Market // Market of each token ID
+	orderResponse string                    // Body of /order responses
+	requests      map[string]int            // Requests per path
+}
+
+func newMarketServer(t *testing.T, markets map[string]*models.Market) *marketServer {
+	t.Helper()
+	s := &marketServer{markets: markets, requests: make(map[string]int)}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.requests[r.URL.Path]++
+		market := s.markets[r.URL.Query().Get("clob_token_ids")]
+		s.mu.Unlock()
+
+		switch r.URL.Path {
+		case "/tick-size":
+			json.NewEncoder(w).Encode(&GetTickSizeResponse{MinimumTickSize: 0.01})
+		case "/markets":
+			result := []*models.Market{}
+			if market != nil {
+				result = append(result, market)
+			}
+			json.NewEncoder(w).Encode(result)
+		case "/order":
+			s.mu.Lock()
+			body := s.orderResponse
+			s.mu.Unlock()
+			w.Write([]byte(body))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *marketServer) count(path string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests[path]
+}
+
+// testMarket market accepting orders of at least 5 shares until endDate
+func testMarket(acceptingOrders, closed bool, endDate time.Time) *models.Market {
+	minSize := 5.0
+	return &models.Market{AcceptingOrders: &acceptingOrders, Closed: &closed, OrderMinSize: &minSize, EndDate: &endDate}
+}
+
+// expectViolations checks that err is a *ValidationError violating exactly the rules
+func expectViolations(t *testing.T, name string, err error, rules ...ValidationRule) {
+	t.Helper()
+	if len(rules) == 0 {
+		if err != nil {
+			t.Errorf("%s: %v", name, err)
+		}
+		return
+	}
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		t.Errorf("%s: error = %v, want a *ValidationError", name, err)
+		return
+	}
+	if len(verr.Violations) != len(rules) {
+		t.Errorf("%s: violations = %+v, want %v", name, verr.Violations, rules)
+		return
+	}
+	for _, rule := range rules {
+		if !verr.Violates(rule) {
+			t.Errorf("%s: violations = %+v, want %s", name, verr.Violations, rule)
+		}
+	}
+}
+
+func TestValidateOrder(t *testing.T) {
+	endDate := time.Now().Add(24 * time.Hour)
+	server := newMarketServer(t, map[string]*models.Market{
+		"open":     testMarket(true, false, endDate),
+		"paused":   testMarket(false, false, endDate),
+		"closed":   testMarket(false, true, endDate),
+		"no-limit": {},
+	})
+	orders := NewOrdersAPI(newTestClient(t, server.URL))
+
+	tests := []struct {
+		name       string
+		tokenID    string
+		price      string
+		size       string
+		orderType  models.OrderType
+		expiration time.Time
+		want       []ValidationRule
+	}{
+		{"valid", "open", "0.5", "10", models.OrderTypeGTC, time.Time{}, nil},
+		{"valid GTD", "open", "0.5", "10", models.OrderTypeGTD, time.Now().Add(time.Hour), nil},
+		{"zero price", "open", "0", "10", models.OrderTypeGTC, time.Time{}, []ValidationRule{ValidationRulePrice}},
+		{"price of one", "open", "1", "10", models.OrderTypeGTC, time.Time{}, []ValidationRule{ValidationRulePrice}},
+		{"price off tick", "open", "0.555", "10", models.OrderTypeGTC, time.Time{}, []ValidationRule{ValidationRuleTickSize}},
+		{"size below minimum", "open", "0.5", "2", models.OrderTypeGTC, time.Time{}, []ValidationRule{ValidationRuleMinSize}},
+		{"zero size", "open", "0.5", "0", models.OrderTypeGTC, time.Time{}, []ValidationRule{ValidationRuleSize, ValidationRuleMinSize}},
+		{"zero size without minimum", "no-limit", "0.5", "0", models.OrderTypeGTC, time.Time{}, []ValidationRule{ValidationRuleSize}},
+		{"negative size without minimum", "no-limit", "0.5", "-1", models.OrderTypeGTC, time.Time{}, []ValidationRule{ValidationRuleSize}},
+		{"not accepting orders", "paused", "0.5", "10", models.OrderTypeGTC, time.Time{}, []ValidationRule{ValidationRuleAcceptingOrders}},
+		{"closed", "closed", "0.5", "10", models.OrderTypeGTC, time.Time{}, []ValidationRule{ValidationRuleAcceptingOrders, ValidationRuleClosed}},
+		{"GTD without expiration", "open", "0.5", "10", models.OrderTypeGTD, time.Time{}, []ValidationRule{ValidationRuleExpiration}},
+		{"GTD within lead time", "open", "0.5", "10", models.OrderTypeGTD, time.Now().Add(30 * time.Second), []ValidationRule{ValidationRuleExpiration}},
+		{"GTD after end date", "open", "0.5", "10", models.OrderTypeGTD, endDate.Add(time.Hour), []ValidationRule{ValidationRuleExpiration}},
+		{"expiration of a GTC order", "open", "0.5", "10", models.OrderTypeGTC, time.Now().Add(time.Hour), []ValidationRule{ValidationRuleExpiration}},
+	}
+	for _, tt := range tests {
+		err := orders.ValidateOrder(&models.CreateAndPostOrderParams{
+			TokenID: tt.tokenID,
+			Price:   models.MustDecimal(tt.price),
+			Size:    models.MustDecimal(tt.size),
+		}, &models.CreateAndPostOrderConfig{Expiration: tt.expiration}, tt.orderType)
+		expectViolations(t, tt.name, err, tt.want...)
+	}
+
+	if err := orders.ValidateOrder(&models.CreateAndPostOrderParams{TokenID: "unknown"}, &models.CreateAndPostOrderConfig{}, models.OrderTypeGTC); err == nil {
+		t.Error("token without market expected an error")
+	}
+}
+
+func TestValidateMarketOrder(t *testing.T) {
+	endDate := time.Now().Add(24 * time.Hour)
+	server := newMarketServer(t, map[string]*models.Market{
+		"open":   testMarket(true, false, endDate),
+		"closed": testMarket(false, true, endDate),
+	})
+	orders := NewOrdersAPI(newTestClient(t, server.URL))
+
+	tests := []struct {
+		name    string
+		tokenID string
+		amount  string
+		price   string
+		want    []ValidationRule
+	}{
+		{"valid", "open", "10", "0", nil},
+		{"valid with price", "open", "10", "0.55", nil},
+		{"amount below the minimum size", "open", "1", "0", nil},
+		{"zero amount", "open", "0", "0", []ValidationRule{ValidationRuleSize}},
+		{"negative amount", "open", "-5", "0", []ValidationRule{ValidationRuleSize}},
+		{"price above one", "open", "10", "1.5", []ValidationRule{ValidationRulePrice}},
+		{"negative price", "open", "10", "-0.5", []ValidationRule{ValidationRulePrice}},
+		{"price off tick", "open", "10", "0.555", []ValidationRule{ValidationRuleTickSize}},
+		{"closed", "closed", "10", "0", []ValidationRule{ValidationRuleAcceptingOrders, ValidationRuleClosed}},
+	}
+	for _, tt := range tests {
+		err := orders.ValidateMarketOrder(&models.CreateMarketOrderParams{
+			TokenID: tt.tokenID,
+			Amount:  models.MustDecimal(tt.amount),
+			Price:   models.MustDecimal(tt.price),
+		}, &models.CreateAndPostOrderConfig{})
+		expectViolations(t, tt.name, err, tt.want...)
+	}
+}
+
+func TestCreateAndPostMarketOrderValidatesBeforeQuoting(t *testing.T) {
+	server := newMarketServer(t, map[string]*models.Market{
+		"closed": testMarket(false, true, time.Now().Add(24*time.Hour)),
+	})
+	orders := NewOrdersAPI(newTestClient(t, server.URL))
+
+	// The orderbook is never requested, the server fails the test on /book
+	_, err := orders.CreateAndPostMarketOrderCtx(context.Background(), &models.CreateMarketOrderParams{
+		TokenID: "closed",
+		Amount:  models.MustDecimal("10"),
+	}, &models.CreateAndPostOrderConfig{}, models.OrderTypeFOK)
+	expectViolations(t, "closed market", err, ValidationRuleAcceptingOrders, ValidationRuleClosed)
+}
+
+func TestMarketConstraintsCache(t *testing.T) {
+	endDate := time.Now().Add(24 * time.Hour)
+	server := newMarketServer(t, map[string]*models.Market{
+		"a": testMarket(true, false, endDate),
+		"b": testMarket(true, false, endDate),
+	})
+	orders := newL2OrdersAPI(t, server.URL)
+
+	validate := func(tokenID string) error {
+		return orders.ValidateOrder(&models.CreateAndPostOrderParams{
+			TokenID: tokenID,
+			Price:   models.MustDecimal("0.5"),
+			Size:    models.MustDecimal("10"),
+		}, &models.CreateAndPostOrderConfig{}, models.OrderTypeGTC)
+	}
+	expectRequests := func(name string, markets, tickSizes int) {
+		t.Helper()
+		if got := server.count("/markets"); got != markets {
+			t.Errorf("%s: %d market lookups, want %d", name, got, markets)
+		}
+		if got := server.count("/tick-size"); got != tickSizes {
+			t.Errorf("%s: %d tick size lookups, want %d", name, got, tickSizes)
+		}
+	}
+	setMarket := func(tokenID string, market *models.Market) {
+		server.mu.Lock()
+		server.markets[tokenID] = market
+		server.mu.Unlock()
+	}
+
+	for range 3 {
+		if err := validate("a"); err != nil {
+			t.Fatalf("validate: %v", err)
+		}
+	}
+	expectRequests("cached", 1, 1)
+
+	// The market stops accepting orders, its status is trusted until the TTL expires
+	setMarket("a", testMarket(false, false, endDate))
+	if err := validate("a"); err != nil {
+		t.Errorf("cached market: %v", err)
+	}
+	orders.mu.Lock()
+	orders.markets["a"].fetchedAt = time.Now().Add(-marketStatusTTL)
+	orders.mu.Unlock()
+	expectViolations(t, "expired status", validate("a"), ValidationRuleAcceptingOrders)
+	expectRequests("expired status", 2, 1)
+
+	// A paused market is fetched again on every order, until it reopens
+	expectViolations(t, "paused market", validate("a"), ValidationRuleAcceptingOrders)
+	setMarket("a", testMarket(true, false, endDate))
+	if err := validate("a"); err != nil {
+		t.Errorf("reopened market: %v", err)
+	}
+	validate("a")
+	expectRequests("reopened market", 4, 1)
+
+	// An order rejected for the state of its market drops the cached market
+	server.mu.Lock()
+	server.orderResponse = `{"success":false,"errorMsg":"the market is not accepting orders"}`
+	server.mu.Unlock()
+	_, err := orders.CreateOrder(&models.SignedOrder{TokenID: "a"}, models.OrderTypeGTC, "")
+	if !client.IsMarketUnavailable(err) {
+		t.Errorf("rejected order: error = %v, want a market unavailable error", err)
+	}
+	validate("a")
+	expectRequests("rejected order", 5, 2)
+
+	// Other rejections keep it
+	server.mu.Lock()
+	server.orderResponse = `{"success":false,"errorMsg":"not enough balance / allowance"}`
+	server.mu.Unlock()
+	orders.CreateOrder(&models.SignedOrder{TokenID: "a"}, models.OrderTypeGTC, "")
+	validate("a")
+	expectRequests("balance rejection", 5, 2)
+
+	orders.InvalidateMarketCache("a")
+	validate("a")
+	expectRequests("invalidated token", 6, 3)
+
+	if err := validate("b"); err != nil {
+		t.Fatalf("validate: %v", err)
+	}
+	orders.InvalidateMarketCache("")
+	validate("a")
+	validate("b")
+	expectRequests("invalidated every token", 9, 6)
+}
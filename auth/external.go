@@ -0,0 +1,197 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// SignPurpose what a hash is signed for, so that an external signer can apply its own policy
+type SignPurpose string
+
+const (
+	// SignPurposeClobAuth L1 authentication (EIP-712 ClobAuth message)
+	SignPurposeClobAuth SignPurpose = "clob_auth"
+	// SignPurposeOrder order signature (EIP-712 Order of the CTF Exchange)
+	SignPurposeOrder SignPurpose = "order"
+	// SignPurposeTransaction Polygon transaction (e.g. exchange nonce increment)
+	SignPurposeTransaction SignPurpose = "transaction"
+)
+
+// SignRequest hash signing request sent to a HashSigner
+type SignRequest struct {
+	Purpose SignPurpose    // What the hash is signed for
+	Address common.Address // Expected signing address
+	Hash    common.Hash    // EIP-712 hash to sign
+}
+
+// HashSigner signs EIP-712 hashes with a secp256k1 key it holds, typically outside the process
+// (remote signing service, cloud KMS, HSM). Implement it to plug any key management system.
+type HashSigner interface {
+	// Address returns the address of the signing key
+	Address() common.Address
+	// SignHash returns a 65 bytes signature (r, s, v), v may be either {0, 1} or {27, 28}
+	// It must give up when ctx is done, ctx carries the deadline of the SDK call being signed
+	SignHash(ctx context.Context, request *SignRequest) ([]byte, error)
+}
+
+// ExternalSigner Signer delegating every wallet signature to a HashSigner, so that the private key
+// never enters the process. Signatures are checked against the expected address before use.
+type ExternalSigner struct {
+	hashSigner HashSigner
+}
+
+// NewExternalSigner creates a Signer from a HashSigner
+func NewExternalSigner(hashSigner HashSigner) (*ExternalSigner, error) {
+	if hashSigner == nil {
+		return nil, fmt.Errorf("hash signer is required")
+	}
+	if hashSigner.Address() == (common.Address{}) {
+		return nil, fmt.Errorf("hash signer has no address")
+	}
+
+	return &ExternalSigner{hashSigner: hashSigner}, nil
+}
+
+// Address returns the checksummed address of the signing key
+func (s *ExternalSigner) Address() string {
+	return s.hashSigner.Address().Hex()
+}
+
+// String implements fmt.Stringer
+func (s *ExternalSigner) String() string {
+	return fmt.Sprintf("ExternalSigner{address: %s}", s.Address())
+}
+
+// LogValue implements slog.LogValuer
+func (s *ExternalSigner) LogValue() slog.Value {
+	return slog.GroupValue(slog.String("address", s.Address()))
+}
+
+// SignL1Auth generates L1 authentication signature (EIP-712)
+// Reference: https://docs.polymarket.com/developers/CLOB/authentication
+func (s *ExternalSigner) SignL1Auth(chainID int, address string, timestamp int64, nonce *big.Int) (*L1AuthHeaders, error) {
+	return s.SignL1AuthCtx(context.Background(), chainID, address, timestamp, nonce)
+}
+
+// SignL1AuthCtx is like SignL1Auth but bound to ctx
+func (s *ExternalSigner) SignL1AuthCtx(ctx context.Context, chainID int, address string, timestamp int64, nonce *big.Int) (*L1AuthHeaders, error) {
+	if nonce == nil {
+		nonce = big.NewInt(0)
+	}
+
+	hash, err := ClobAuthHash(chainID, address, timestamp, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := s.sign(ctx, SignPurposeClobAuth, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	return newL1AuthHeaders(address, timestamp, nonce, signature)
+}
+
+// SignL2Auth generates L2 authentication signature (HMAC with the API secret)
+func (s *ExternalSigner) SignL2Auth(address, method, path, body string, timestamp int64, apiKey, secret, passphrase string) (*L2AuthHeaders, error) {
+	return BuildL2AuthHeaders(address, method, path, body, timestamp, apiKey, secret, passphrase)
+}
+
+// SignOrderHash signs the EIP-712 hash of an order
+func (s *ExternalSigner) SignOrderHash(orderHash common.Hash) ([]byte, error) {
+	return s.SignOrderHashCtx(context.Background(), orderHash)
+}
+
+// SignOrderHashCtx is like SignOrderHash but bound to ctx
+func (s *ExternalSigner) SignOrderHashCtx(ctx context.Context, orderHash common.Hash) ([]byte, error) {
+	return s.sign(ctx, SignPurposeOrder, orderHash)
+}
+
+// SignTransactionHash signs the signing hash of a transaction
+func (s *ExternalSigner) SignTransactionHash(txHash common.Hash) ([]byte, error) {
+	return s.SignTransactionHashCtx(context.Background(), txHash)
+}
+
+// SignTransactionHashCtx is like SignTransactionHash but bound to ctx
+func (s *ExternalSigner) SignTransactionHashCtx(ctx context.Context, txHash common.Hash) ([]byte, error) {
+	return s.sign(ctx, SignPurposeTransaction, txHash)
+}
+
+// sign asks the HashSigner for a signature, normalizes v to {27, 28} and checks the recovered address
+func (s *ExternalSigner) sign(ctx context.Context, purpose SignPurpose, hash common.Hash) ([]byte, error) {
+	address := s.hashSigner.Address()
+	signature, err := s.hashSigner.SignHash(ctx, &SignRequest{
+		Purpose: purpose,
+		Address: address,
+		Hash:    hash,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("external sign %s: %w", purpose, err)
+	}
+
+	if len(signature) != 65 {
+		return nil, fmt.Errorf("external sign %s: invalid signature length: %d", purpose, len(signature))
+	}
+	signature = append([]byte(nil), signature...)
+	if signature[64] < 27 {
+		signature[64] += 27
+	}
+
+	recovered, err := recoverAddress(hash, signature)
+	if err != nil {
+		return nil, fmt.Errorf("external sign %s: %w", purpose, err)
+	}
+	if recovered != address {
+		return nil, fmt.Errorf("external sign %s: signature recovers to %s, expected %s", purpose, recovered.Hex(), address.Hex())
+	}
+
+	return signature, nil
+}
+
+// secp256k1HalfN half of the secp256k1 curve order, signatures must have s <= N/2 (EIP-2)
+var secp256k1HalfN = new(big.Int).Rsh(crypto.S256().Params().N, 1)
+
+// SignatureFromRS builds a 65 bytes signature (r, s, v) from the r and s values returned by a KMS
+// (e.g. parsed from an ASN.1 DER signature), normalizing s to the lower half of the curve order
+// and finding the recovery id v for which the signature recovers to address
+func SignatureFromRS(hash common.Hash, r, s *big.Int, address common.Address) ([]byte, error) {
+	if r == nil || s == nil || r.Sign() <= 0 || s.Sign() <= 0 {
+		return nil, fmt.Errorf("invalid signature values")
+	}
+
+	s = new(big.Int).Set(s)
+	if s.Cmp(secp256k1HalfN) > 0 {
+		s.Sub(crypto.S256().Params().N, s)
+	}
+
+	signature := make([]byte, 65)
+	r.FillBytes(signature[0:32])
+	s.FillBytes(signature[32:64])
+
+	for v := byte(27); v <= 28; v++ {
+		signature[64] = v
+		recovered, err := recoverAddress(hash, signature)
+		if err == nil && recovered == address {
+			return signature, nil
+		}
+	}
+
+	return nil, fmt.Errorf("signature does not recover to %s", address.Hex())
+}
+
+// recoverAddress recovers the signing address of a 65 bytes signature with v in {27, 28}
+func recoverAddress(hash common.Hash, signature []byte) (common.Address, error) {
+	sig := append([]byte(nil), signature...)
+	sig[64] -= 27
+
+	publicKey, err := crypto.SigToPub(hash.Bytes(), sig)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("recover signer: %w", err)
+	}
+	return crypto.PubkeyToAddress(*publicKey), nil
+}
@@ -0,0 +1,198 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// testPrivateKey well-known development key of testAddress
+const testPrivateKey = "ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80"
+
+const testAddress = "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"
+
+var testHash = common.HexToHash("0x5c5c0e2b4f5d0f1e6b3c7f0a8a3e2d4c9b1a0f7e6d5c4b3a2918070605040302")
+
+// keySigner HashSigner signing with a local key, as a KMS would
+type keySigner struct {
+	key     *ecdsa.PrivateKey
+	address common.Address // Reported address, default the address of key
+	modify  func([]byte) []byte
+	ctx     context.Context // Context of the last request
+}
+
+func newKeySigner(t *testing.T) *keySigner {
+	t.Helper()
+	key, err := crypto.HexToECDSA(testPrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &keySigner{key: key, address: crypto.PubkeyToAddress(key.PublicKey)}
+}
+
+func (s *keySigner) Address() common.Address {
+	return s.address
+}
+
+func (s *keySigner) SignHash(ctx context.Context, request *SignRequest) ([]byte, error) {
+	s.ctx = ctx
+	signature, err := crypto.Sign(request.Hash.Bytes(), s.key) // v in {0, 1}
+	if err != nil || s.modify == nil {
+		return signature, err
+	}
+	return s.modify(signature), nil
+}
+
+func TestNewExternalSigner(t *testing.T) {
+	if _, err := NewExternalSigner(nil); err == nil {
+		t.Error("nil hash signer expected an error")
+	}
+	hashSigner := newKeySigner(t)
+	hashSigner.address = common.Address{}
+	if _, err := NewExternalSigner(hashSigner); err == nil {
+		t.Error("hash signer without address expected an error")
+	}
+}
+
+func TestExternalSignerMatchesPrivateKeySigner(t *testing.T) {
+	signer, err := NewExternalSigner(newKeySigner(t))
+	if err != nil {
+		t.Fatalf("NewExternalSigner: %v", err)
+	}
+	local, err := NewPrivateKeySigner(testPrivateKey)
+	if err != nil {
+		t.Fatalf("NewPrivateKeySigner: %v", err)
+	}
+	if signer.Address() != testAddress {
+		t.Errorf("Address() = %s, want %s", signer.Address(), testAddress)
+	}
+
+	// v is normalized to {27, 28}, the signatures are deterministic (RFC 6979)
+	got, err := signer.SignOrderHash(testHash)
+	if err != nil {
+		t.Fatalf("SignOrderHash: %v", err)
+	}
+	want, _ := local.SignOrderHash(testHash)
+	if string(got) != string(want) || got[64] < 27 {
+		t.Errorf("SignOrderHash = %x, want %x", got, want)
+	}
+
+	gotL1, err := signer.SignL1Auth(PolygonChainID, testAddress, 1700000000, big.NewInt(3))
+	if err != nil {
+		t.Fatalf("SignL1Auth: %v", err)
+	}
+	wantL1, _ := local.SignL1Auth(PolygonChainID, testAddress, 1700000000, big.NewInt(3))
+	if *gotL1 != *wantL1 {
+		t.Errorf("SignL1Auth = %+v, want %+v", gotL1, wantL1)
+	}
+}
+
+func TestExternalSignerChecksSignature(t *testing.T) {
+	other, _ := crypto.GenerateKey()
+	tests := []struct {
+		name   string
+		modify func([]byte) []byte
+		want   string
+	}{
+		{"other key", func([]byte) []byte {
+			signature, _ := crypto.Sign(testHash.Bytes(), other)
+			return signature
+		}, "signature recovers to"},
+		{"short signature", func(signature []byte) []byte { return signature[:64] }, "invalid signature length"},
+		{"flipped recovery id", func(signature []byte) []byte {
+			signature[64] ^= 1
+			return signature
+		}, "signature recovers to"},
+	}
+	for _, tt := range tests {
+		hashSigner := newKeySigner(t)
+		hashSigner.modify = tt.modify
+		signer, _ := NewExternalSigner(hashSigner)
+		if _, err := signer.SignOrderHash(testHash); err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%s: error = %v, want %q", tt.name, err, tt.want)
+		}
+	}
+}
+
+func TestSignHelpersPassContext(t *testing.T) {
+	type ctxKey struct{}
+	ctx := context.WithValue(context.Background(), ctxKey{}, "call")
+
+	hashSigner := newKeySigner(t)
+	signer, _ := NewExternalSigner(hashSigner)
+	calls := map[string]func() error{
+		"SignL1AuthCtx": func() error {
+			_, err := SignL1AuthCtx(ctx, signer, PolygonChainID, testAddress, 1700000000, nil)
+			return err
+		},
+		"SignOrderHashCtx": func() error {
+			_, err := SignOrderHashCtx(ctx, signer, testHash)
+			return err
+		},
+		"SignTransactionHashCtx": func() error {
+			_, err := SignTransactionHashCtx(ctx, signer, testHash)
+			return err
+		},
+	}
+	for name, call := range calls {
+		hashSigner.ctx = nil
+		if err := call(); err != nil {
+			t.Errorf("%s: %v", name, err)
+		}
+		if hashSigner.ctx == nil || hashSigner.ctx.Value(ctxKey{}) != "call" {
+			t.Errorf("%s: hash signer did not get the context of the call", name)
+		}
+	}
+
+	// Signers without context support are called directly
+	local, _ := NewPrivateKeySigner(testPrivateKey)
+	if _, err := SignOrderHashCtx(ctx, local, testHash); err != nil {
+		t.Errorf("SignOrderHashCtx(PrivateKeySigner): %v", err)
+	}
+}
+
+func TestSignatureFromRS(t *testing.T) {
+	key, _ := crypto.HexToECDSA(testPrivateKey)
+	address := crypto.PubkeyToAddress(key.PublicKey)
+	curveN := crypto.S256().Params().N
+
+	for i := range 8 {
+		hash := crypto.Keccak256Hash(testHash.Bytes(), []byte{byte(i)})
+		want, err := crypto.Sign(hash.Bytes(), key) // Low-S, v in {0, 1}
+		if err != nil {
+			t.Fatal(err)
+		}
+		want[64] += 27
+		r := new(big.Int).SetBytes(want[0:32])
+		s := new(big.Int).SetBytes(want[32:64])
+
+		// A KMS may return either S, both give the low-S signature with v in {27, 28}
+		for _, candidate := range []*big.Int{s, new(big.Int).Sub(curveN, s)} {
+			got, err := SignatureFromRS(hash, r, candidate, address)
+			if err != nil {
+				t.Fatalf("SignatureFromRS: %v", err)
+			}
+			if string(got) != string(want) {
+				t.Errorf("hash %d: SignatureFromRS = %x, want %x", i, got, want)
+			}
+		}
+	}
+
+	r, s := big.NewInt(1), big.NewInt(1)
+	if _, err := SignatureFromRS(testHash, nil, s, address); err == nil {
+		t.Error("nil r expected an error")
+	}
+	if _, err := SignatureFromRS(testHash, r, big.NewInt(0), address); err == nil {
+		t.Error("zero s expected an error")
+	}
+	signature, _ := crypto.Sign(testHash.Bytes(), key)
+	r, s = new(big.Int).SetBytes(signature[0:32]), new(big.Int).SetBytes(signature[32:64])
+	if _, err := SignatureFromRS(testHash, r, s, common.HexToAddress("0x70997970C51812dc3A010C7d01b50e0d17dc79C8")); err == nil {
+		t.Error("signature of another address expected an error")
+	}
+}
@@ -0,0 +1,113 @@
+package auth
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// WalletFactories CREATE2 parameters of the Polymarket wallet factories of a chain
+// The proxy wallet (Email/Magic login) and the Gnosis Safe (browser wallet login) of an EOA are
+// deployed at deterministic addresses, so the funder address can be computed before deployment.
+type WalletFactories struct {
+	ProxyFactory      common.Address // Polymarket proxy wallet factory
+	ProxyInitCodeHash common.Hash    // Init code hash of the proxy wallet
+	SafeFactory       common.Address // Polymarket Gnosis Safe proxy factory
+	SafeInitCodeHash  common.Hash    // Init code hash of the Safe proxy
+}
+
+// PolygonWalletFactories returns the wallet factories deployed on Polygon mainnet
+func PolygonWalletFactories() *WalletFactories {
+	return &WalletFactories{
+		ProxyFactory:      common.HexToAddress("0xaB45c5A4B0c941a2F231C04C3f49182e1A254052"),
+		ProxyInitCodeHash: common.HexToHash("0xd21df8dc65880a8606f09fe0ce3df9b8869287ab0b058be05aa9e8af6330a00b"),
+		SafeFactory:       common.HexToAddress("0xaacFeEa03eb1561C4e67d661e40682Bd20E3541b"),
+		SafeInitCodeHash:  common.HexToHash("0x2bce2127ff07fb632d16c8347c4ebf501f4841168bed00d9e6ef715ddb6fcecf"),
+	}
+}
+
+// ProxyWalletAddress returns the proxy wallet address of an EOA (SignatureTypeEmailMagic funder)
+// The factory salt is keccak256(abi.encodePacked(owner))
+func (f *WalletFactories) ProxyWalletAddress(owner common.Address) common.Address {
+	var salt [32]byte
+	copy(salt[:], crypto.Keccak256(owner.Bytes()))
+	return crypto.CreateAddress2(f.ProxyFactory, salt, f.ProxyInitCodeHash.Bytes())
+}
+
+// SafeAddress returns the Gnosis Safe address of an EOA (SignatureTypeBrowserWallet funder)
+// The factory salt is keccak256(abi.encode(owner))
+func (f *WalletFactories) SafeAddress(owner common.Address) common.Address {
+	var salt [32]byte
+	copy(salt[:], crypto.Keccak256(common.LeftPadBytes(owner.Bytes(), 32)))
+	return crypto.CreateAddress2(f.SafeFactory, salt, f.SafeInitCodeHash.Bytes())
+}
+
+// WalletFactoriesForChain returns the wallet factories of a chain
+// Only Polygon mainnet has known factories, other chains (Amoy) return an error
+func WalletFactoriesForChain(chainID int) (*WalletFactories, error) {
+	if chainID == PolygonChainID {
+		return PolygonWalletFactories(), nil
+	}
+	return nil, fmt.Errorf("no known wallet factories on chain %d", chainID)
+}
+
+// ProxyWalletAddress returns the proxy wallet address of an EOA on a chain
+func ProxyWalletAddress(chainID int, owner string) (string, error) {
+	factories, ownerAddress, err := walletFactoriesOf(chainID, owner)
+	if err != nil {
+		return "", err
+	}
+	return factories.ProxyWalletAddress(ownerAddress).Hex(), nil
+}
+
+// SafeAddress returns the Gnosis Safe address of an EOA on a chain
+func SafeAddress(chainID int, owner string) (string, error) {
+	factories, ownerAddress, err := walletFactoriesOf(chainID, owner)
+	if err != nil {
+		return "", err
+	}
+	return factories.SafeAddress(ownerAddress).Hex(), nil
+}
+
+// ExpectedMaker returns the order maker of a signer for a signature type: the signer itself for 0 (EOA),
+// its proxy wallet for 1 (Email/Magic) or its Gnosis Safe for 2 (browser wallet). factories may be nil when
+// they are unknown on the chain, known is then false for the wallet signature types.
+func ExpectedMaker(factories *WalletFactories, signatureType int, signer common.Address) (maker common.Address, known bool, err error) {
+	switch signatureType {
+	case 0:
+		return signer, true, nil
+	case 1, 2:
+		if factories == nil {
+			return common.Address{}, false, nil
+		}
+		if signatureType == 1 {
+			return factories.ProxyWalletAddress(signer), true, nil
+		}
+		return factories.SafeAddress(signer), true, nil
+	}
+	return common.Address{}, false, fmt.Errorf("unsupported signature type: %d", signatureType)
+}
+
+// MakerSignatureType returns the signature type whose maker derived from signer is maker (see ExpectedMaker),
+// ok is false when maker is neither the signer nor one of its wallets
+func MakerSignatureType(factories *WalletFactories, signer, maker common.Address) (signatureType int, ok bool) {
+	for _, candidate := range []int{0, 1, 2} {
+		if expected, known, _ := ExpectedMaker(factories, candidate, signer); known && expected == maker {
+			return candidate, true
+		}
+	}
+	return 0, false
+}
+
+// walletFactoriesOf returns the wallet factories of a chain and the parsed owner address
+func walletFactoriesOf(chainID int, owner string) (*WalletFactories, common.Address, error) {
+	if !common.IsHexAddress(owner) {
+		return nil, common.Address{}, fmt.Errorf("invalid owner address: %s", owner)
+	}
+	factories, err := WalletFactoriesForChain(chainID)
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+	return factories, common.HexToAddress(owner), nil
+}
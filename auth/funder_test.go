@@ -0,0 +1,135 @@
+package auth
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// walletVectors proxy wallet and Safe addresses of development EOAs on Polygon, computed with the
+// factory constants (no wallet is deployed for these keys). TestWalletAddressesDeployed checks the
+// constants against wallets of real accounts.
+var walletVectors = []struct {
+	owner string
+	proxy string
+	safe  string
+}{
+	{
+		owner: "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266",
+		proxy: "0x365f0CA36Ae1f641E02fE3B7743673da42A13A70",
+		safe:  "0xd93B25cb943D14d0d34FBaF01Fc93a0f8b5F6E47",
+	},
+	{
+		owner: "0x70997970C51812dc3A010C7d01b50e0d17dc79C8",
+		proxy: "0xd9d24e482c11F586cd9A1a53dC3eEc6dE3883862",
+		safe:  "0x8ac5D4Bd2752AFc9F5CA531f19D617647216B893",
+	},
+}
+
+func TestWalletAddresses(t *testing.T) {
+	for _, tt := range walletVectors {
+		// Owners are accepted in any case
+		for _, owner := range []string{tt.owner, strings.ToLower(tt.owner)} {
+			proxy, err := ProxyWalletAddress(PolygonChainID, owner)
+			if err != nil || proxy != tt.proxy {
+				t.Errorf("ProxyWalletAddress(%s) = %s, %v, want %s", owner, proxy, err, tt.proxy)
+			}
+			safe, err := SafeAddress(PolygonChainID, owner)
+			if err != nil || safe != tt.safe {
+				t.Errorf("SafeAddress(%s) = %s, %v, want %s", owner, safe, err, tt.safe)
+			}
+		}
+	}
+}
+
+// TestWalletAddressesCreate2 recomputes the vectors from the CREATE2 formula
+// keccak256(0xff ++ factory ++ salt ++ initCodeHash)[12:], independently of crypto.CreateAddress2
+func TestWalletAddressesCreate2(t *testing.T) {
+	create2 := func(factory common.Address, salt []byte, initCodeHash common.Hash) string {
+		data := append([]byte{0xff}, factory.Bytes()...)
+		data = append(data, salt...)
+		data = append(data, initCodeHash.Bytes()...)
+		return common.BytesToAddress(crypto.Keccak256(data)[12:]).Hex()
+	}
+
+	factories := PolygonWalletFactories()
+	for _, tt := range walletVectors {
+		owner := common.HexToAddress(tt.owner)
+		// Proxy salt: keccak256(abi.encodePacked(owner)), Safe salt: keccak256(abi.encode(owner))
+		proxy := create2(factories.ProxyFactory, crypto.Keccak256(owner.Bytes()), factories.ProxyInitCodeHash)
+		safe := create2(factories.SafeFactory, crypto.Keccak256(common.LeftPadBytes(owner.Bytes(), 32)), factories.SafeInitCodeHash)
+		if proxy != tt.proxy {
+			t.Errorf("proxy of %s = %s, want %s", tt.owner, proxy, tt.proxy)
+		}
+		if safe != tt.safe {
+			t.Errorf("safe of %s = %s, want %s", tt.owner, safe, tt.safe)
+		}
+	}
+}
+
+// TestWalletAddressesDeployed checks the derived wallets against deployed ones listed in
+// POLYMARKET_WALLET_VECTORS as comma-separated owner=wallet pairs: the EOA signing the orders of a
+// Polymarket account and the address of its profile (its proxy wallet or Safe)
+func TestWalletAddressesDeployed(t *testing.T) {
+	vectors := os.Getenv("POLYMARKET_WALLET_VECTORS")
+	if vectors == "" {
+		t.Skip("POLYMARKET_WALLET_VECTORS not set")
+	}
+	for _, vector := range strings.Split(vectors, ",") {
+		owner, wallet, ok := strings.Cut(strings.TrimSpace(vector), "=")
+		if !ok || !common.IsHexAddress(owner) || !common.IsHexAddress(wallet) {
+			t.Fatalf("invalid vector %q, want owner=wallet", vector)
+		}
+		proxy, _ := ProxyWalletAddress(PolygonChainID, owner)
+		safe, _ := SafeAddress(PolygonChainID, owner)
+		if want := common.HexToAddress(wallet).Hex(); want != proxy && want != safe {
+			t.Errorf("wallet of %s = %s, derived proxy %s and Safe %s", owner, want, proxy, safe)
+		}
+	}
+}
+
+func TestWalletAddressesUnknownChain(t *testing.T) {
+	owner := walletVectors[0].owner
+	if _, err := WalletFactoriesForChain(AmoyChainID); err == nil {
+		t.Error("WalletFactoriesForChain(Amoy) expected an error")
+	}
+	if _, err := ProxyWalletAddress(AmoyChainID, owner); err == nil {
+		t.Error("ProxyWalletAddress on Amoy expected an error")
+	}
+	if _, err := SafeAddress(AmoyChainID, owner); err == nil {
+		t.Error("SafeAddress on Amoy expected an error")
+	}
+	if _, err := ProxyWalletAddress(PolygonChainID, "not-an-address"); err == nil {
+		t.Error("ProxyWalletAddress of an invalid owner expected an error")
+	}
+}
+
+func TestExpectedMaker(t *testing.T) {
+	factories := PolygonWalletFactories()
+	for _, tt := range walletVectors {
+		owner := common.HexToAddress(tt.owner)
+		for signatureType, want := range []string{tt.owner, tt.proxy, tt.safe} {
+			maker, known, err := ExpectedMaker(factories, signatureType, owner)
+			if err != nil || !known || maker.Hex() != want {
+				t.Errorf("ExpectedMaker(%d, %s) = %s, %v, %v, want %s", signatureType, tt.owner, maker.Hex(), known, err, want)
+			}
+			if got, ok := MakerSignatureType(factories, owner, common.HexToAddress(want)); !ok || got != signatureType {
+				t.Errorf("MakerSignatureType(%s, %s) = %d, %v, want %d", tt.owner, want, got, ok, signatureType)
+			}
+		}
+	}
+
+	owner := common.HexToAddress(walletVectors[0].owner)
+	if _, known, err := ExpectedMaker(nil, 1, owner); known || err != nil {
+		t.Errorf("ExpectedMaker without factories = %v, %v, want unknown", known, err)
+	}
+	if _, _, err := ExpectedMaker(factories, 3, owner); err == nil {
+		t.Error("ExpectedMaker of signature type 3 expected an error")
+	}
+	if _, ok := MakerSignatureType(factories, owner, common.HexToAddress(walletVectors[1].owner)); ok {
+		t.Error("MakerSignatureType of an unrelated address should not match")
+	}
+}
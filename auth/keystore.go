@@ -0,0 +1,30 @@
+package auth
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+)
+
+// NewKeystoreSigner creates a signer from a go-ethereum encrypted JSON keystore (V3)
+// The key is decrypted once, the raw hex key never has to be written in configuration or environment
+func NewKeystoreSigner(keyJSON []byte, passphrase string) (*PrivateKeySigner, error) {
+	key, err := keystore.DecryptKey(keyJSON, passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("decrypt keystore: %w", err)
+	}
+
+	return &PrivateKeySigner{
+		privateKey: key.PrivateKey,
+	}, nil
+}
+
+// NewKeystoreSignerFromFile creates a signer from a go-ethereum encrypted JSON keystore file
+func NewKeystoreSignerFromFile(path, passphrase string) (*PrivateKeySigner, error) {
+	keyJSON, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read keystore: %w", err)
+	}
+	return NewKeystoreSigner(keyJSON, passphrase)
+}
@@ -0,0 +1,63 @@
+package auth
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// testKeystore encrypts the test key with passphrase, with light scrypt parameters
+func testKeystore(t *testing.T, passphrase string) []byte {
+	t.Helper()
+	privateKey, err := crypto.HexToECDSA(testPrivateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := &keystore.Key{Address: crypto.PubkeyToAddress(privateKey.PublicKey), PrivateKey: privateKey}
+	keyJSON, err := keystore.EncryptKey(key, passphrase, keystore.LightScryptN, keystore.LightScryptP)
+	if err != nil {
+		t.Fatalf("EncryptKey: %v", err)
+	}
+	return keyJSON
+}
+
+func TestNewKeystoreSigner(t *testing.T) {
+	keyJSON := testKeystore(t, "correct horse")
+
+	signer, err := NewKeystoreSigner(keyJSON, "correct horse")
+	if err != nil {
+		t.Fatalf("NewKeystoreSigner: %v", err)
+	}
+	if signer.Address() != testAddress {
+		t.Errorf("Address() = %s, want %s", signer.Address(), testAddress)
+	}
+
+	if _, err := NewKeystoreSigner(keyJSON, "wrong"); err == nil {
+		t.Error("wrong passphrase expected an error")
+	}
+	if _, err := NewKeystoreSigner([]byte(`{}`), "correct horse"); err == nil {
+		t.Error("invalid keystore expected an error")
+	}
+}
+
+func TestNewKeystoreSignerFromFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keystore.json")
+	if err := os.WriteFile(path, testKeystore(t, "pass"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	signer, err := NewKeystoreSignerFromFile(path, "pass")
+	if err != nil {
+		t.Fatalf("NewKeystoreSignerFromFile: %v", err)
+	}
+	if signer.Address() != testAddress {
+		t.Errorf("Address() = %s, want %s", signer.Address(), testAddress)
+	}
+
+	if _, err := NewKeystoreSignerFromFile(filepath.Join(t.TempDir(), "missing.json"), "pass"); err == nil {
+		t.Error("missing file expected an error")
+	}
+}
@@ -0,0 +1,137 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// RemoteSignRequest request body of the remote signing protocol
+//
+// The SDK POSTs it as JSON to the signing service, which answers with a RemoteSignResponse.
+// The hash is the final EIP-712 digest (or transaction signing hash), the purpose and address let the service apply its policy.
+type RemoteSignRequest struct {
+	Purpose SignPurpose `json:"purpose"` // "clob_auth", "order" or "transaction"
+	Address string      `json:"address"` // Checksummed address of the key to sign with
+	Hash    string      `json:"hash"`    // 0x-prefixed 32 bytes hash
+}
+
+// RemoteSignResponse response body of the remote signing protocol
+type RemoteSignResponse struct {
+	Signature string `json:"signature"`       // 0x-prefixed 65 bytes signature (r, s, v)
+	Error     string `json:"error,omitempty"` // Reason the request was refused, if any
+}
+
+// RemoteSignerConfig remote signer configuration
+type RemoteSignerConfig struct {
+	URL        string        // Signing endpoint (required)
+	Address    string        // Address of the remote key (required)
+	Header     http.Header   // Extra headers sent with every request (e.g. Authorization)
+	HTTPClient *http.Client  // HTTP client, default http.DefaultClient
+	Timeout    time.Duration // Timeout of a signing request, default 10s
+}
+
+// RemoteSigner HashSigner calling a remote signing service over HTTP
+// Wrap it with NewExternalSigner to use it as a Signer
+type RemoteSigner struct {
+	url        string
+	address    common.Address
+	header     http.Header
+	httpClient *http.Client
+	timeout    time.Duration
+}
+
+// NewRemoteSigner creates a remote signer
+func NewRemoteSigner(config *RemoteSignerConfig) (*RemoteSigner, error) {
+	if config == nil || config.URL == "" {
+		return nil, fmt.Errorf("remote signer URL is required")
+	}
+	if !common.IsHexAddress(config.Address) {
+		return nil, fmt.Errorf("invalid remote signer address: %s", config.Address)
+	}
+
+	httpClient := config.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	timeout := config.Timeout
+	if timeout == 0 {
+		timeout = 10 * time.Second
+	}
+
+	return &RemoteSigner{
+		url:        config.URL,
+		address:    common.HexToAddress(config.Address),
+		header:     config.Header.Clone(),
+		httpClient: httpClient,
+		timeout:    timeout,
+	}, nil
+}
+
+// Address returns the address of the remote key
+func (s *RemoteSigner) Address() common.Address {
+	return s.address
+}
+
+// SignHash asks the remote service to sign a hash, within the configured timeout and the deadline of ctx
+func (s *RemoteSigner) SignHash(ctx context.Context, request *SignRequest) ([]byte, error) {
+	body, err := json.Marshal(&RemoteSignRequest{
+		Purpose: request.Purpose,
+		Address: request.Address.Hex(),
+		Hash:    request.Hash.Hex(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshal sign request: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("create sign request: %w", err)
+	}
+	for key, values := range s.header {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("send sign request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read sign response: %w", err)
+	}
+
+	var response RemoteSignResponse
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		if resp.StatusCode >= 400 {
+			return nil, fmt.Errorf("remote signer: status %d: %s", resp.StatusCode, strings.TrimSpace(string(respBody)))
+		}
+		return nil, fmt.Errorf("unmarshal sign response: %w", err)
+	}
+	if resp.StatusCode >= 400 || response.Error != "" {
+		return nil, fmt.Errorf("remote signer: status %d: %s", resp.StatusCode, response.Error)
+	}
+
+	signature, err := hexutil.Decode(response.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("decode signature: %w", err)
+	}
+	return signature, nil
+}
@@ -0,0 +1,133 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestNewRemoteSigner(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *RemoteSignerConfig
+	}{
+		{"nil config", nil},
+		{"no URL", &RemoteSignerConfig{Address: testAddress}},
+		{"invalid address", &RemoteSignerConfig{URL: "http://signer", Address: "0x1234"}},
+	}
+	for _, tt := range tests {
+		if _, err := NewRemoteSigner(tt.config); err == nil {
+			t.Errorf("%s: expected an error", tt.name)
+		}
+	}
+}
+
+func TestRemoteSigner(t *testing.T) {
+	key, _ := crypto.HexToECDSA(testPrivateKey)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("request %s with content type %q, want a JSON POST", r.Method, r.Header.Get("Content-Type"))
+		}
+		if got := r.Header.Get("Authorization"); got != "Bearer token" {
+			t.Errorf("Authorization = %q", got)
+		}
+
+		var request RemoteSignRequest
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			t.Errorf("decode request: %v", err)
+		}
+		if request.Purpose != SignPurposeOrder || request.Address != testAddress || request.Hash != testHash.Hex() {
+			t.Errorf("request = %+v", request)
+		}
+
+		signature, _ := crypto.Sign(testHash.Bytes(), key) // v in {0, 1}
+		json.NewEncoder(w).Encode(&RemoteSignResponse{Signature: hexutil.Encode(signature)})
+	}))
+	defer server.Close()
+
+	remote, err := NewRemoteSigner(&RemoteSignerConfig{
+		URL:     server.URL,
+		Address: strings.ToLower(testAddress),
+		Header:  http.Header{"Authorization": {"Bearer token"}},
+	})
+	if err != nil {
+		t.Fatalf("NewRemoteSigner: %v", err)
+	}
+	signer, err := NewExternalSigner(remote)
+	if err != nil {
+		t.Fatalf("NewExternalSigner: %v", err)
+	}
+
+	signature, err := signer.SignOrderHash(testHash)
+	if err != nil {
+		t.Fatalf("SignOrderHash: %v", err)
+	}
+	local, _ := NewPrivateKeySigner(testPrivateKey)
+	if want, _ := local.SignOrderHash(testHash); string(signature) != string(want) {
+		t.Errorf("SignOrderHash = %x, want %x", signature, want)
+	}
+}
+
+func TestRemoteSignerErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		want   string
+	}{
+		{"refused", http.StatusForbidden, `{"error":"purpose not allowed"}`, "status 403: purpose not allowed"},
+		{"refused with status 200", http.StatusOK, `{"error":"address not allowed"}`, "address not allowed"},
+		{"plain text error", http.StatusBadGateway, "upstream unavailable\n", "status 502: upstream unavailable"},
+		{"invalid JSON", http.StatusOK, "signature", "unmarshal sign response"},
+		{"invalid signature", http.StatusOK, `{"signature":"0xzz"}`, "decode signature"},
+	}
+	for _, tt := range tests {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(tt.status)
+			w.Write([]byte(tt.body))
+		}))
+		remote, _ := NewRemoteSigner(&RemoteSignerConfig{URL: server.URL, Address: testAddress})
+		_, err := remote.SignHash(context.Background(), &SignRequest{Purpose: SignPurposeOrder, Hash: testHash})
+		server.Close()
+
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%s: error = %v, want %q", tt.name, err, tt.want)
+		}
+	}
+}
+
+func TestRemoteSignerContext(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release // Never answers
+	}))
+	defer server.Close()
+	defer close(release)
+
+	// The deadline of the call bounds the request, even with a longer signer timeout
+	remote, _ := NewRemoteSigner(&RemoteSignerConfig{URL: server.URL, Address: testAddress, Timeout: time.Minute})
+	signer, _ := NewExternalSigner(remote)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if _, err := signer.SignOrderHashCtx(ctx, testHash); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("SignOrderHashCtx error = %v, want context.DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("SignOrderHashCtx returned after %v", elapsed)
+	}
+
+	// The signer timeout bounds calls without deadline
+	remote, _ = NewRemoteSigner(&RemoteSignerConfig{URL: server.URL, Address: testAddress, Timeout: 50 * time.Millisecond})
+	if _, err := remote.SignHash(context.Background(), &SignRequest{Hash: testHash}); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("SignHash error = %v, want context.DeadlineExceeded", err)
+	}
+}
@@ -1,6 +1,7 @@
 package auth
 
 import (
+	"context"
 	"crypto/aes"
 	"crypto/cipher"
 	"crypto/ecdsa"
@@ -9,6 +10,7 @@ import (
 	"encoding/base64"
 	"encoding/hex"
 	"fmt"
+	"log/slog"
 	"math/big"
 	"strconv"
 	"time"
@@ -22,6 +24,8 @@ import (
 const (
 	// PolygonChainID Polygon mainnet Chain ID
 	PolygonChainID = 137
+	// AmoyChainID Polygon Amoy testnet Chain ID
+	AmoyChainID = 80002
 	// ClobAuthDomainName EIP-712 Domain name
 	ClobAuthDomainName = "ClobAuthDomain"
 	// ClobAuthDomainVersion EIP-712 Domain version
@@ -49,12 +53,83 @@ type L2AuthHeaders struct {
 	Passphrase string // POLY_PASSPHRASE: Polymarket API key passphrase
 }
 
+// redacted placeholder logged instead of secret values
+const redacted = "[REDACTED]"
+
+// LogValue implements slog.LogValuer, the signature is redacted
+func (h *L1AuthHeaders) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("address", h.Address),
+		slog.String("signature", redacted),
+		slog.String("timestamp", h.Timestamp),
+		slog.String("nonce", h.Nonce),
+	)
+}
+
+// LogValue implements slog.LogValuer, the signature and passphrase are redacted
+func (h *L2AuthHeaders) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("address", h.Address),
+		slog.String("signature", redacted),
+		slog.String("timestamp", h.Timestamp),
+		slog.String("api_key", h.APIKey),
+		slog.String("passphrase", redacted),
+	)
+}
+
 // Signer signer interface
+// Implementations: PrivateKeySigner (raw or keystore key) and ExternalSigner (remote signer, KMS, HSM)
 type Signer interface {
-	// SignL1Auth generates L1 authentication signature
-	SignL1Auth(address string, timestamp int64, nonce *big.Int) (*L1AuthHeaders, error)
+	// Address returns the checksummed address of the signing key
+	Address() string
+	// SignL1Auth generates L1 authentication signature for the given chain
+	SignL1Auth(chainID int, address string, timestamp int64, nonce *big.Int) (*L1AuthHeaders, error)
 	// SignL2Auth generates L2 authentication signature (requires request method, path, body)
 	SignL2Auth(address, method, path, body string, timestamp int64, apiKey, secret, passphrase string) (*L2AuthHeaders, error)
+	// SignOrderHash signs the EIP-712 hash of an order, the signature is 65 bytes (r, s, v) with v in {27, 28}
+	SignOrderHash(orderHash common.Hash) ([]byte, error)
+}
+
+// TransactionSigner optional Signer extension signing Polygon transactions, used by on-chain helpers
+// such as the exchange nonce increment. PrivateKeySigner and ExternalSigner implement it.
+type TransactionSigner interface {
+	// SignTransactionHash signs the signing hash of a transaction, the signature is 65 bytes (r, s, v) with v in {27, 28}
+	SignTransactionHash(txHash common.Hash) ([]byte, error)
+}
+
+// ContextSigner optional Signer extension bounding signatures by a context, implemented by signers that
+// may block (ExternalSigner). The SDK passes the context of the call, so cancelling it aborts the signature.
+type ContextSigner interface {
+	// SignL1AuthCtx is like SignL1Auth but bound to ctx
+	SignL1AuthCtx(ctx context.Context, chainID int, address string, timestamp int64, nonce *big.Int) (*L1AuthHeaders, error)
+	// SignOrderHashCtx is like SignOrderHash but bound to ctx
+	SignOrderHashCtx(ctx context.Context, orderHash common.Hash) ([]byte, error)
+	// SignTransactionHashCtx is like SignTransactionHash but bound to ctx
+	SignTransactionHashCtx(ctx context.Context, txHash common.Hash) ([]byte, error)
+}
+
+// SignL1AuthCtx signs L1 authentication with signer, bound to ctx when the signer is a ContextSigner
+func SignL1AuthCtx(ctx context.Context, signer Signer, chainID int, address string, timestamp int64, nonce *big.Int) (*L1AuthHeaders, error) {
+	if ctxSigner, ok := signer.(ContextSigner); ok {
+		return ctxSigner.SignL1AuthCtx(ctx, chainID, address, timestamp, nonce)
+	}
+	return signer.SignL1Auth(chainID, address, timestamp, nonce)
+}
+
+// SignOrderHashCtx signs an order hash with signer, bound to ctx when the signer is a ContextSigner
+func SignOrderHashCtx(ctx context.Context, signer Signer, orderHash common.Hash) ([]byte, error) {
+	if ctxSigner, ok := signer.(ContextSigner); ok {
+		return ctxSigner.SignOrderHashCtx(ctx, orderHash)
+	}
+	return signer.SignOrderHash(orderHash)
+}
+
+// SignTransactionHashCtx signs a transaction hash with signer, bound to ctx when the signer is a ContextSigner
+func SignTransactionHashCtx(ctx context.Context, signer TransactionSigner, txHash common.Hash) ([]byte, error) {
+	if ctxSigner, ok := signer.(ContextSigner); ok {
+		return ctxSigner.SignTransactionHashCtx(ctx, txHash)
+	}
+	return signer.SignTransactionHash(txHash)
 }
 
 // PrivateKeySigner signer that uses private key for signing
@@ -74,16 +149,78 @@ func NewPrivateKeySigner(privateKeyHex string) (*PrivateKeySigner, error) {
 	}, nil
 }
 
+// Address returns the checksummed address of the private key
+func (s *PrivateKeySigner) Address() string {
+	return crypto.PubkeyToAddress(s.privateKey.PublicKey).Hex()
+}
+
+// String implements fmt.Stringer without exposing the private key
+func (s *PrivateKeySigner) String() string {
+	return fmt.Sprintf("PrivateKeySigner{address: %s}", s.Address())
+}
+
+// GoString implements fmt.GoStringer without exposing the private key
+func (s *PrivateKeySigner) GoString() string {
+	return s.String()
+}
+
+// LogValue implements slog.LogValuer without exposing the private key
+func (s *PrivateKeySigner) LogValue() slog.Value {
+	return slog.GroupValue(slog.String("address", s.Address()))
+}
+
 // SignL1Auth generates L1 authentication signature (EIP-712)
 // Reference: https://docs.polymarket.com/developers/CLOB/authentication
-func (s *PrivateKeySigner) SignL1Auth(address string, timestamp int64, nonce *big.Int) (*L1AuthHeaders, error) {
+func (s *PrivateKeySigner) SignL1Auth(chainID int, address string, timestamp int64, nonce *big.Int) (*L1AuthHeaders, error) {
+	if nonce == nil {
+		nonce = big.NewInt(0)
+	}
+
+	hash, err := ClobAuthHash(chainID, address, timestamp, nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	// Sign
+	signature, err := crypto.Sign(hash.Bytes(), s.privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("sign: %w", err)
+	}
+
+	return newL1AuthHeaders(address, timestamp, nonce, signature)
+}
+
+// SignOrderHash signs the EIP-712 hash of an order
+func (s *PrivateKeySigner) SignOrderHash(orderHash common.Hash) ([]byte, error) {
+	signature, err := crypto.Sign(orderHash.Bytes(), s.privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("sign order: %w", err)
+	}
+	signature[64] += 27
+	return signature, nil
+}
+
+// SignTransactionHash signs the signing hash of a transaction
+func (s *PrivateKeySigner) SignTransactionHash(txHash common.Hash) ([]byte, error) {
+	signature, err := crypto.Sign(txHash.Bytes(), s.privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("sign transaction: %w", err)
+	}
+	signature[64] += 27
+	return signature, nil
+}
+
+// ClobAuthHash computes the EIP-712 hash of the ClobAuth message signed for L1 authentication
+// The chain ID is part of the EIP-712 domain, so signatures are only valid on the CLOB of that chain
+// Reference: https://docs.polymarket.com/developers/CLOB/authentication
+func ClobAuthHash(chainID int, address string, timestamp int64, nonce *big.Int) (common.Hash, error) {
 	if nonce == nil {
 		nonce = big.NewInt(0)
 	}
 
 	// Validate address format and convert to checksum format
 	if !common.IsHexAddress(address) {
-		return nil, fmt.Errorf("invalid address format: %s", address)
+		return common.Hash{}, fmt.Errorf("invalid address format: %s", address)
 	}
 	// Convert to checksum format (EIP-55)
 	address = common.HexToAddress(address).Hex()
@@ -92,7 +229,7 @@ func (s *PrivateKeySigner) SignL1Auth(address string, timestamp int64, nonce *bi
 	domain := apitypes.TypedDataDomain{
 		Name:    ClobAuthDomainName,
 		Version: ClobAuthDomainVersion,
-		ChainId: math.NewHexOrDecimal256(PolygonChainID),
+		ChainId: math.NewHexOrDecimal256(int64(chainID)),
 	}
 
 	// Build EIP-712 Types
@@ -126,30 +263,26 @@ func (s *PrivateKeySigner) SignL1Auth(address string, timestamp int64, nonce *bi
 		Message:     message,
 	}
 
-	// Sign using EIP-712
 	// Calculate domain separator
 	domainSeparator, err := typedData.HashStruct("EIP712Domain", typedData.Domain.Map())
 	if err != nil {
-		return nil, fmt.Errorf("hash domain: %w", err)
+		return common.Hash{}, fmt.Errorf("hash domain: %w", err)
 	}
 
 	// Calculate typed data hash
 	typedDataHash, err := typedData.HashStruct(typedData.PrimaryType, typedData.Message)
 	if err != nil {
-		return nil, fmt.Errorf("hash message: %w", err)
+		return common.Hash{}, fmt.Errorf("hash message: %w", err)
 	}
 
 	// EIP-712 final hash: keccak256("\x19\x01" || domainSeparator || typedDataHash)
 	rawData := append([]byte("\x19\x01"), domainSeparator...)
 	rawData = append(rawData, typedDataHash...)
-	hash := crypto.Keccak256(rawData)
-
-	// Sign
-	signature, err := crypto.Sign(hash, s.privateKey)
-	if err != nil {
-		return nil, fmt.Errorf("sign: %w", err)
-	}
+	return crypto.Keccak256Hash(rawData), nil
+}
 
+// newL1AuthHeaders builds the L1 headers from a ClobAuth signature
+func newL1AuthHeaders(address string, timestamp int64, nonce *big.Int, signature []byte) (*L1AuthHeaders, error) {
 	// Ensure signature is 65 bytes (r + s + v)
 	if len(signature) != 65 {
 		return nil, fmt.Errorf("invalid signature length: %d", len(signature))
@@ -162,22 +295,19 @@ func (s *PrivateKeySigner) SignL1Auth(address string, timestamp int64, nonce *bi
 		signature[64] += 27
 	}
 
-	// Signature needs 0x prefix
-	signatureHex := "0x" + hex.EncodeToString(signature)
-
 	return &L1AuthHeaders{
-		Address:   address, // Address is already in checksum format
-		Signature: signatureHex,
+		Address:   common.HexToAddress(address).Hex(), // Checksum format
+		Signature: "0x" + hex.EncodeToString(signature),
 		Timestamp: strconv.FormatInt(timestamp, 10),
 		Nonce:     nonce.String(),
 	}, nil
 }
 
-// SignL1AuthWithDefaults generates L1 authentication signature with default values
+// SignL1AuthWithDefaults generates L1 authentication signature with default values (Polygon mainnet)
 func (s *PrivateKeySigner) SignL1AuthWithDefaults(address string) (*L1AuthHeaders, error) {
 	timestamp := time.Now().Unix()
 	nonce := big.NewInt(0)
-	return s.SignL1Auth(address, timestamp, nonce)
+	return s.SignL1Auth(PolygonChainID, address, timestamp, nonce)
 }
 
 // decryptSecret decrypts secret using passphrase
@@ -260,6 +390,13 @@ func toBase64(s string) string {
 // Reference: https://docs.polymarket.com/developers/CLOB/authentication
 // Reference: Polymarket clob-client buildPolyHmacSignature implementation
 func (s *PrivateKeySigner) SignL2Auth(address, method, path, body string, timestamp int64, apiKey, secret, passphrase string) (*L2AuthHeaders, error) {
+	return BuildL2AuthHeaders(address, method, path, body, timestamp, apiKey, secret, passphrase)
+}
+
+// BuildL2AuthHeaders builds the L2 headers, signing the request with the API secret (HMAC-SHA256)
+// L2 authentication does not involve the wallet key, so every Signer implementation can use it
+// Reference: https://docs.polymarket.com/developers/CLOB/authentication
+func BuildL2AuthHeaders(address, method, path, body string, timestamp int64, apiKey, secret, passphrase string) (*L2AuthHeaders, error) {
 	// Build message string to sign
 	// Format: timestamp + method + path + (body if exists)
 	message := fmt.Sprintf("%d%s%s", timestamp, method, path)
@@ -1,14 +1,20 @@
 package client
 
 import (
-	"bytes"
-	"encoding/json"
+	"context"
+	"errors"
 	"fmt"
-	"io"
+	"log/slog"
 	"net/http"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/mtt-labs/poly-market-sdk/auth"
+
+	"github.com/ethereum/go-ethereum/common"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // SignatureType signature type
@@ -24,34 +30,69 @@ const (
 	SignatureTypeBrowserWallet SignatureType = 2
 )
 
+// DefaultBaseURL default CLOB API address
+const DefaultBaseURL = "https://clob.polymarket.com"
+
 // Client is the main client of Polymarket SDK
+//
+// A Client is safe for concurrent use by multiple goroutines. The configuration (URLs, chain, signer,
+// funder, retry and rate limit policies) is fixed at construction. The API credentials are the only
+// mutable state: they are swapped atomically as a whole (key, secret and passphrase together), so a
+// request is always signed with a consistent set, either the previous or the new one.
 type Client struct {
-	baseURL       string
-	httpClient    *http.Client
-	apiKey        string        // API key (obtained via create_or_derive_api_creds)
-	apiSecret     string        // API secret
-	apiPassphrase string        // API passphrase
-	privateKey    string        // Private key (for signing)
-	chainID       int           // Chain ID, default 137 (Polygon)
-	signatureType SignatureType // Signature type
-	funder        string        // Proxy address (based on login method)
-	signer        auth.Signer   // Signer
-	address       string        // Address derived from private key
+	pipeline      *pipeline                   // Request pipeline for the CLOB API
+	gamma         *GammaClient                // Gamma API client sharing the HTTP client, retries and rate limiter
+	data          *DataClient                 // Data API client sharing the HTTP client, retries and rate limiter
+	creds         atomic.Pointer[Credentials] // API credentials (obtained via create_or_derive_api_creds), never nil
+	privateKey    string                      // Private key (for signing)
+	network       *Network                    // Network profile (chain, contracts)
+	rpcURL        string                      // Polygon JSON-RPC endpoint for on-chain helpers
+	chainID       int                         // Chain ID, default 137 (Polygon)
+	signatureType SignatureType               // Signature type
+	funder        string                      // Order maker: signer address, proxy wallet or Gnosis Safe (based on signature type)
+	signer        auth.Signer                 // Signer
+	address       string                      // Address derived from private key
+	clock         *clock                      // Clock synced with the CLOB server for POLY_TIMESTAMP and GTD expirations
+
+	credentialStore CredentialStore                                 // Store persisting API credentials (optional)
+	autoRefresh     bool                                            // Refresh credentials and retry once on authentication errors
+	refresher       func(ctx context.Context) (*Credentials, error) // Creates or derives fresh credentials (set by api.NewAuthAPI)
+	refreshMu       sync.Mutex                                      // Serializes credential refreshes
 }
 
 // Config is the client configuration
 // Reference: https://docs.polymarket.com/quickstart/orders/first-order
 type Config struct {
-	BaseURL       string        // API base URL, default "https://clob.polymarket.com"
-	PrivateKey    string        // Private key (required)
-	ChainID       int           // Chain ID, default 137 (Polygon)
-	SignatureType SignatureType // Signature type (0=EOA, 1=Email/Magic, 2=Browser Wallet)
-	Funder        string        // Proxy address (based on login method, optional)
-	APIKey        string        // API key (optional, can be obtained via create_or_derive_api_creds)
-	APISecret     string        // API secret (optional)
-	APIPassphrase string        // API passphrase (optional)
-	Timeout       time.Duration
-	HTTPClient    *http.Client
+	Network       *Network         // Network profile (MainnetNetwork, AmoyNetwork), default from ChainID
+	BaseURL       string           // API base URL, default the network's CLOB URL ("https://clob.polymarket.com")
+	GammaBaseURL  string           // Gamma API base URL, default the network's Gamma URL ("https://gamma-api.polymarket.com")
+	DataBaseURL   string           // Data API base URL, default the network's Data URL ("https://data-api.polymarket.com")
+	RPCURL        string           // Polygon JSON-RPC endpoint for on-chain helpers (exchange nonces), default the network's public RPC
+	PrivateKey    string           // Private key (required unless Signer is set)
+	Signer        auth.Signer      // Signer holding the wallet key (keystore, remote signer, KMS), replaces PrivateKey
+	ChainID       int              // Chain ID, default the network's chain ID or 137 (Polygon)
+	SignatureType SignatureType    // Signature type (0=EOA, 1=Email/Magic, 2=Browser Wallet)
+	Funder        string           // Proxy address (based on login method), default derived from the signer and SignatureType
+	APIKey        string           // API key (optional, can be obtained via create_or_derive_api_creds)
+	APISecret     string           // API secret (optional)
+	APIPassphrase string           // API passphrase (optional)
+	Timeout       time.Duration    // HTTP timeout per attempt, default 30s (ignored when HTTPClient is set)
+	HTTPClient    *http.Client     // HTTP client shared by the CLOB, Gamma and Data API clients
+	RetryPolicy   *RetryPolicy     // Retry policy, default DefaultRetryPolicy(), use NoRetryPolicy() to disable
+	RateLimit     *RateLimitConfig // Client-side rate limits, default DefaultRateLimits() with RateLimitWait
+	Interceptors  []Interceptor    // Interceptors applied to every CLOB, Gamma and Data API request (first is outermost)
+	Logger        *slog.Logger     // Logger for request tracing (debug level), secrets are always redacted; nil disables logging
+	TimeSync      *TimeSyncConfig  // Server time sync for signed timestamps, enabled by default
+	// Store persisting API credentials: the default nonce set is loaded on startup when APIKey is empty and saved after create/derive
+	CredentialStore CredentialStore
+	// On 401/403 from an L2 request, reload credentials from the store (or derive them again) and retry once
+	AutoRefreshCredentials bool
+	// Use a Funder that does not match the maker derived from the signer and SignatureType (e.g. a wallet of
+	// another factory), a warning is logged instead of failing with ErrFunderMismatch
+	SkipFunderCheck bool
+	// OpenTelemetry providers for spans and metrics of every API call, nil uses the global providers (no-op by default)
+	TracerProvider trace.TracerProvider
+	MeterProvider  metric.MeterProvider
 }
 
 // NewClient creates a new Polymarket client
@@ -61,18 +102,88 @@ func NewClient(config *Config) (*Client, error) {
 		return nil, fmt.Errorf("config is required")
 	}
 
-	if config.PrivateKey == "" {
-		return nil, fmt.Errorf("private key is required")
+	network, err := resolveNetwork(config)
+	if err != nil {
+		return nil, err
+	}
+
+	var signer auth.Signer
+	if config.Signer != nil {
+		if config.PrivateKey != "" {
+			return nil, fmt.Errorf("private key and signer are mutually exclusive")
+		}
+		signer = config.Signer
+	} else {
+		if config.PrivateKey == "" {
+			return nil, fmt.Errorf("private key or signer is required")
+		}
+
+		// Create signer
+		privateKeySigner, err := auth.NewPrivateKeySigner(config.PrivateKey)
+		if err != nil {
+			return nil, fmt.Errorf("create signer: %w", err)
+		}
+		signer = privateKeySigner
+	}
+
+	address := signer.Address()
+	funder, err := resolveFunder(network, config.SignatureType, address, config.Funder)
+	mismatch := errors.Is(err, ErrFunderMismatch) && config.SkipFunderCheck
+	if mismatch {
+		funder = common.HexToAddress(config.Funder).Hex()
+	} else if err != nil {
+		return nil, err
+	}
+
+	c := newClient(config, network, signer, address)
+	c.funder = funder
+	if mismatch {
+		c.pipeline.logger.LogAttrs(context.Background(), slog.LevelWarn, "polymarket funder check skipped",
+			slog.String("error", err.Error()))
+	}
+	return loadCredentials(c, config)
+}
+
+// NewPublicClient creates a read-only client without a private key
+// Public endpoints (Gamma, Data API and CLOB market data) work as usual, while
+// authenticated requests fail with ErrNoSigner. config may be nil to use defaults.
+func NewPublicClient(config *Config) (*Client, error) {
+	if config == nil {
+		config = &Config{}
+	}
+
+	if config.PrivateKey != "" || config.Signer != nil {
+		return nil, fmt.Errorf("public client must not be given a private key or signer, use NewClient instead")
+	}
+
+	network, err := resolveNetwork(config)
+	if err != nil {
+		return nil, err
 	}
 
+	return newClient(config, network, nil, ""), nil
+}
+
+// newClient creates a client from config with an optional signer
+func newClient(config *Config, network *Network, signer auth.Signer, address string) *Client {
 	baseURL := config.BaseURL
 	if baseURL == "" {
-		baseURL = "https://clob.polymarket.com" // Polymarket default API address
+		baseURL = network.CLOBBaseURL
+	}
+
+	gammaBaseURL := config.GammaBaseURL
+	if gammaBaseURL == "" {
+		gammaBaseURL = network.GammaBaseURL
 	}
 
-	chainID := config.ChainID
-	if chainID == 0 {
-		chainID = 137 // Polygon mainnet
+	dataBaseURL := config.DataBaseURL
+	if dataBaseURL == "" {
+		dataBaseURL = network.DataBaseURL
+	}
+
+	rpcURL := config.RPCURL
+	if rpcURL == "" {
+		rpcURL = network.RPCURL
 	}
 
 	timeout := config.Timeout
@@ -87,127 +198,263 @@ func NewClient(config *Config) (*Client, error) {
 		}
 	}
 
-	// Create signer
-	signer, err := auth.NewPrivateKeySigner(config.PrivateKey)
-	if err != nil {
-		return nil, fmt.Errorf("create signer: %w", err)
+	retryPolicy := config.RetryPolicy
+	if retryPolicy == nil {
+		retryPolicy = DefaultRetryPolicy()
 	}
 
-	// Get address from private key
-	address, err := auth.GetAddressFromPrivateKey(config.PrivateKey)
-	if err != nil {
-		return nil, fmt.Errorf("get address from private key: %w", err)
+	logger := config.Logger
+	if logger == nil {
+		logger = discardLogger()
 	}
 
-	return &Client{
-		baseURL:       baseURL,
-		httpClient:    httpClient,
+	// The CLOB, Gamma and Data API clients share the transport, retries, rate limiter and interceptors
+	shared := pipeline{
+		logger:    logger,
+		telemetry: newTelemetry(config.TracerProvider, config.MeterProvider),
+		handler:   chainInterceptors(httpClient, config.Interceptors),
+		retry:     retryPolicy,
+		limiter:   NewRateLimiter(config.RateLimit),
+	}
+
+	c := &Client{
+		pipeline:      shared.forHost(APICLOB, baseURL, clobEndpointGroup),
+		gamma:         &GammaClient{pipeline: shared.forHost(APIGamma, gammaBaseURL, gammaEndpointGroup)},
+		data:          &DataClient{pipeline: shared.forHost(APIData, dataBaseURL, dataEndpointGroup)},
 		privateKey:    config.PrivateKey,
-		chainID:       chainID,
+		network:       network,
+		rpcURL:        rpcURL,
+		chainID:       network.ChainID,
 		signatureType: config.SignatureType,
 		funder:        config.Funder,
-		apiKey:        config.APIKey,
-		apiSecret:     config.APISecret,
-		apiPassphrase: config.APIPassphrase,
 		signer:        signer,
 		address:       address,
-	}, nil
-}
+		clock:         newClock(config.TimeSync),
 
-// doRequest executes HTTP request
-func (c *Client) doRequest(method, endpoint string, body interface{}, l1Headers *auth.L1AuthHeaders, l2Headers *auth.L2AuthHeaders) ([]byte, error) {
-	var reqBody io.Reader
-	if body != nil {
-		jsonData, err := json.Marshal(body)
-		if err != nil {
-			return nil, fmt.Errorf("marshal request body: %w", err)
-		}
-		reqBody = bytes.NewBuffer(jsonData)
-	}
-
-	url := c.baseURL + endpoint
-	req, err := http.NewRequest(method, url, reqBody)
-	if err != nil {
-		return nil, fmt.Errorf("create request: %w", err)
-	}
-
-	req.Header.Set("Content-Type", "application/json")
-
-	// Add L1 Header (if needed)
-	// Reference: https://docs.polymarket.com/developers/CLOB/authentication
-	if l1Headers != nil {
-		req.Header.Set("POLY_ADDRESS", l1Headers.Address)
-		req.Header.Set("POLY_SIGNATURE", l1Headers.Signature)
-		req.Header.Set("POLY_TIMESTAMP", l1Headers.Timestamp)
-		req.Header.Set("POLY_NONCE", l1Headers.Nonce)
+		credentialStore: config.CredentialStore,
+		autoRefresh:     config.AutoRefreshCredentials,
 	}
+	c.SetAPICredentials(config.APIKey, config.APISecret, config.APIPassphrase)
+	return c
+}
 
-	// Add L2 Header (if needed)
-	// Reference: https://docs.polymarket.com/developers/CLOB/authentication
-	if l2Headers != nil {
-		req.Header.Set("POLY_ADDRESS", l2Headers.Address)
-		req.Header.Set("POLY_SIGNATURE", l2Headers.Signature)
-		req.Header.Set("POLY_TIMESTAMP", l2Headers.Timestamp)
-		req.Header.Set("POLY_API_KEY", l2Headers.APIKey)
-		req.Header.Set("POLY_PASSPHRASE", l2Headers.Passphrase)
+// loadCredentials loads the API credentials from the credential store when none are configured
+func loadCredentials(c *Client, config *Config) (*Client, error) {
+	if c.credentialStore == nil || config.APIKey != "" {
+		return c, nil
 	}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("execute request: %w", err)
+	creds, err := c.credentialStore.Load(context.Background(), c.address, nil)
+	if errors.Is(err, ErrCredentialsNotFound) {
+		return c, nil
 	}
-	defer resp.Body.Close()
-
-	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("read response body: %w", err)
+		return nil, fmt.Errorf("load API credentials: %w", err)
 	}
 
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("API error: status %d, body: %s", resp.StatusCode, string(respBody))
-	}
+	c.SetAPICredentials(creds.Key, creds.Secret, creds.Passphrase)
+	return c, nil
+}
 
-	return respBody, nil
+// doRequest executes HTTP request
+// The request is bound to ctx, so cancelling ctx aborts it even before the HTTP client timeout
+func (c *Client) doRequest(ctx context.Context, method, endpoint string, body interface{}, l1Headers *auth.L1AuthHeaders, l2Headers *auth.L2AuthHeaders) ([]byte, error) {
+	return c.pipeline.do(ctx, &request{
+		method:    method,
+		endpoint:  endpoint,
+		body:      body,
+		l1Headers: l1Headers,
+		l2Headers: l2Headers,
+	})
 }
 
 // Get executes GET request
 func (c *Client) Get(endpoint string) ([]byte, error) {
-	return c.doRequest(http.MethodGet, endpoint, nil, nil, nil)
+	return c.GetCtx(context.Background(), endpoint)
+}
+
+// GetCtx executes GET request bound to ctx
+func (c *Client) GetCtx(ctx context.Context, endpoint string) ([]byte, error) {
+	return c.doRequest(ctx, http.MethodGet, endpoint, nil, nil, nil)
 }
 
 // GetWithL1 executes GET request (with L1 Header)
 func (c *Client) GetWithL1(endpoint string, l1Headers *auth.L1AuthHeaders) ([]byte, error) {
-	return c.doRequest(http.MethodGet, endpoint, nil, l1Headers, nil)
+	return c.GetWithL1Ctx(context.Background(), endpoint, l1Headers)
+}
+
+// GetWithL1Ctx executes GET request (with L1 Header) bound to ctx
+func (c *Client) GetWithL1Ctx(ctx context.Context, endpoint string, l1Headers *auth.L1AuthHeaders) ([]byte, error) {
+	return c.doRequest(ctx, http.MethodGet, endpoint, nil, l1Headers, nil)
 }
 
 // GetWithL2 executes GET request (with L2 Header)
 func (c *Client) GetWithL2(endpoint string, l2Headers *auth.L2AuthHeaders) ([]byte, error) {
-	return c.doRequest(http.MethodGet, endpoint, nil, nil, l2Headers)
+	return c.GetWithL2Ctx(context.Background(), endpoint, l2Headers)
+}
+
+// GetWithL2Ctx executes GET request (with L2 Header) bound to ctx
+func (c *Client) GetWithL2Ctx(ctx context.Context, endpoint string, l2Headers *auth.L2AuthHeaders) ([]byte, error) {
+	return c.doRequest(ctx, http.MethodGet, endpoint, nil, nil, l2Headers)
 }
 
 // Post executes POST request
 func (c *Client) Post(endpoint string, body interface{}) ([]byte, error) {
-	return c.doRequest(http.MethodPost, endpoint, body, nil, nil)
+	return c.PostCtx(context.Background(), endpoint, body)
+}
+
+// PostCtx executes POST request bound to ctx
+func (c *Client) PostCtx(ctx context.Context, endpoint string, body interface{}) ([]byte, error) {
+	return c.doRequest(ctx, http.MethodPost, endpoint, body, nil, nil)
 }
 
 // PostWithL1 executes POST request (with L1 Header)
 func (c *Client) PostWithL1(endpoint string, body interface{}, l1Headers *auth.L1AuthHeaders) ([]byte, error) {
-	return c.doRequest(http.MethodPost, endpoint, body, l1Headers, nil)
+	return c.PostWithL1Ctx(context.Background(), endpoint, body, l1Headers)
+}
+
+// PostWithL1Ctx executes POST request (with L1 Header) bound to ctx
+func (c *Client) PostWithL1Ctx(ctx context.Context, endpoint string, body interface{}, l1Headers *auth.L1AuthHeaders) ([]byte, error) {
+	return c.doRequest(ctx, http.MethodPost, endpoint, body, l1Headers, nil)
 }
 
 // PostWithL2 executes POST request (with L2 Header)
 func (c *Client) PostWithL2(endpoint string, body interface{}, l2Headers *auth.L2AuthHeaders) ([]byte, error) {
-	return c.doRequest(http.MethodPost, endpoint, body, nil, l2Headers)
+	return c.PostWithL2Ctx(context.Background(), endpoint, body, l2Headers)
+}
+
+// PostWithL2Ctx executes POST request (with L2 Header) bound to ctx
+func (c *Client) PostWithL2Ctx(ctx context.Context, endpoint string, body interface{}, l2Headers *auth.L2AuthHeaders) ([]byte, error) {
+	return c.doRequest(ctx, http.MethodPost, endpoint, body, nil, l2Headers)
 }
 
 // DeleteWithL2 executes DELETE request (with L2 Header)
 func (c *Client) DeleteWithL2(endpoint string, body interface{}, l2Headers *auth.L2AuthHeaders) ([]byte, error) {
-	return c.doRequest(http.MethodDelete, endpoint, body, nil, l2Headers)
+	return c.DeleteWithL2Ctx(context.Background(), endpoint, body, l2Headers)
+}
+
+// DeleteWithL2Ctx executes DELETE request (with L2 Header) bound to ctx
+func (c *Client) DeleteWithL2Ctx(ctx context.Context, endpoint string, body interface{}, l2Headers *auth.L2AuthHeaders) ([]byte, error) {
+	return c.doRequest(ctx, http.MethodDelete, endpoint, body, nil, l2Headers)
+}
+
+// DoWithL2Ctx executes a request authenticated with the client's API credentials
+// Unlike the *WithL2 methods, L2 headers are signed by the client for every attempt,
+// so the request stays valid when it is retried, and POLY_TIMESTAMP follows the server clock
+func (c *Client) DoWithL2Ctx(ctx context.Context, method, endpoint string, body interface{}) ([]byte, error) {
+	// A read-only client fails without a server time round trip
+	if c.signer == nil {
+		return nil, ErrNoSigner
+	}
+	c.EnsureTimeSync(ctx)
+
+	key := c.GetAPIKey()
+	data, err := c.doWithL2(ctx, method, endpoint, body)
+	if err == nil || !c.autoRefresh || !IsAuth(err) {
+		return data, err
+	}
+
+	// The key may have been rotated or revoked, refresh the credentials and retry once
+	if refreshErr := c.refreshCredentials(ctx, key); refreshErr != nil {
+		return nil, fmt.Errorf("%w (refresh credentials: %v)", err, refreshErr)
+	}
+	return c.doWithL2(ctx, method, endpoint, body)
+}
+
+// doWithL2 executes a request signed with the current API credentials
+func (c *Client) doWithL2(ctx context.Context, method, endpoint string, body interface{}) ([]byte, error) {
+	return c.pipeline.do(ctx, &request{
+		method:   method,
+		endpoint: endpoint,
+		body:     body,
+		signL2: func(reqBody string) (*auth.L2AuthHeaders, error) {
+			return c.SignL2Headers(method, endpoint, reqBody)
+		},
+	})
+}
+
+// SignL2Headers generates L2 authentication headers with the client's API credentials
+// Reference: https://docs.polymarket.com/developers/CLOB/authentication
+func (c *Client) SignL2Headers(method, path, body string) (*auth.L2AuthHeaders, error) {
+	if c.signer == nil {
+		return nil, ErrNoSigner
+	}
+	// Sign with a single snapshot, a concurrent credential swap cannot mix two sets
+	creds := c.APICredentials()
+	if creds.Key == "" {
+		return nil, fmt.Errorf("API key is required")
+	}
+	if creds.Secret == "" || creds.Passphrase == "" {
+		return nil, fmt.Errorf("API secret and passphrase are required")
+	}
+
+	timestamp := c.Now().Unix()
+	return c.signer.SignL2Auth(c.address, method, path, body, timestamp, creds.Key, creds.Secret, creds.Passphrase)
+}
+
+// GammaClient gets the Gamma API client sharing this client's configuration
+func (c *Client) GammaClient() *GammaClient {
+	return c.gamma
+}
+
+// DataClient gets the Data API client sharing this client's configuration
+func (c *Client) DataClient() *DataClient {
+	return c.data
+}
+
+// GetBaseURL gets the CLOB API base URL
+func (c *Client) GetBaseURL() string {
+	return c.pipeline.baseURL
+}
+
+// RateLimiter gets the client-side rate limiter (nil when disabled)
+// Use RateLimiter().Budgets() to monitor the remaining budget of every endpoint group
+func (c *Client) RateLimiter() *RateLimiter {
+	return c.pipeline.limiter
+}
+
+// Logger gets the client logger
+func (c *Client) Logger() *slog.Logger {
+	return c.pipeline.logger
+}
+
+// Telemetry gets the OpenTelemetry instrumentation of the client
+func (c *Client) Telemetry() *Telemetry {
+	return c.pipeline.telemetry
+}
+
+// LogValue implements slog.LogValuer so that logging a client never leaks its secrets
+func (c *Client) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("address", c.address),
+		slog.Int("chain_id", c.chainID),
+		slog.Int("signature_type", int(c.signatureType)),
+		slog.String("funder", c.funder),
+		slog.String("api_key", MaskSecret(c.GetAPIKey())),
+		slog.Bool("read_only", c.signer == nil),
+	)
+}
+
+// String implements fmt.Stringer without exposing the private key or API secrets
+func (c *Client) String() string {
+	return fmt.Sprintf("Client{address: %s, chainID: %d, signatureType: %d, funder: %s, apiKey: %s}",
+		c.address, c.chainID, c.signatureType, c.funder, MaskSecret(c.GetAPIKey()))
+}
+
+// GoString implements fmt.GoStringer so that %#v does not expose secrets either
+func (c *Client) GoString() string {
+	return c.String()
 }
 
 // GetAPIKey gets API key from client config
 func (c *Client) GetAPIKey() string {
-	return c.apiKey
+	return c.creds.Load().Key
+}
+
+// APICredentials gets a consistent snapshot of the API credentials
+// Prefer it to the individual getters when more than one field is needed
+func (c *Client) APICredentials() Credentials {
+	return *c.creds.Load()
 }
 
 // GetAddress gets client address
@@ -215,30 +462,142 @@ func (c *Client) GetAddress() string {
 	return c.address
 }
 
-// GetSigner gets signer
+// GetSigner gets signer (nil for a read-only client)
 func (c *Client) GetSigner() auth.Signer {
 	return c.signer
 }
 
+// RequireSigner gets signer, or ErrNoSigner for a read-only client
+func (c *Client) RequireSigner() (auth.Signer, error) {
+	if c.signer == nil {
+		return nil, ErrNoSigner
+	}
+	return c.signer, nil
+}
+
+// IsReadOnly reports whether the client was created without a signer
+func (c *Client) IsReadOnly() bool {
+	return c.signer == nil
+}
+
 // SetAPICredentials sets API credentials
 // Use after calling create_or_derive_api_creds
+// The three values are swapped atomically, requests in flight keep the set they were signed with
 func (c *Client) SetAPICredentials(key, secret, passphrase string) {
-	c.apiKey = key
-	c.apiSecret = secret
-	c.apiPassphrase = passphrase
+	c.creds.Store(&Credentials{Key: key, Secret: secret, Passphrase: passphrase})
+}
+
+// SaveAPICredentials sets API credentials and persists them in the credential store (if any) as the
+// default nonce credentials. The credentials are set even when saving fails
+func (c *Client) SaveAPICredentials(ctx context.Context, creds *Credentials) error {
+	if !creds.valid() {
+		return fmt.Errorf("API key, secret and passphrase are required")
+	}
+
+	c.SetAPICredentials(creds.Key, creds.Secret, creds.Passphrase)
+
+	if c.credentialStore == nil {
+		return nil
+	}
+	if err := c.credentialStore.Save(ctx, c.address, nil, creds); err != nil {
+		return fmt.Errorf("save API credentials: %w", err)
+	}
+	return nil
+}
+
+// ClearAPICredentials unsets API credentials and removes the default nonce credentials from the credential store (if any)
+func (c *Client) ClearAPICredentials(ctx context.Context) error {
+	c.SetAPICredentials("", "", "")
+
+	if c.credentialStore == nil {
+		return nil
+	}
+	if err := c.credentialStore.Delete(ctx, c.address, nil); err != nil {
+		return fmt.Errorf("delete API credentials: %w", err)
+	}
+	return nil
+}
+
+// CredentialStore gets the credential store (nil if none)
+func (c *Client) CredentialStore() CredentialStore {
+	return c.credentialStore
+}
+
+// SetCredentialRefresher sets the function creating or deriving fresh credentials when the
+// credential store has nothing newer. api.NewAuthAPI registers AuthAPI.CreateOrDeriveAPIKey.
+func (c *Client) SetCredentialRefresher(refresher func(ctx context.Context) (*Credentials, error)) {
+	c.refreshMu.Lock()
+	defer c.refreshMu.Unlock()
+	c.refresher = refresher
+}
+
+// RefreshCredentials replaces the API credentials with the ones in the credential store, or with
+// freshly created or derived ones when the store holds nothing newer
+func (c *Client) RefreshCredentials(ctx context.Context) error {
+	return c.refreshCredentials(ctx, c.GetAPIKey())
+}
+
+// refreshCredentials refreshes the credentials unless another request already replaced staleKey
+func (c *Client) refreshCredentials(ctx context.Context, staleKey string) error {
+	if c.signer == nil {
+		return ErrNoSigner
+	}
+
+	c.refreshMu.Lock()
+	defer c.refreshMu.Unlock()
+
+	// Already refreshed by a concurrent request
+	if c.GetAPIKey() != staleKey {
+		return nil
+	}
+
+	// Another process may have rotated the key and stored the new one
+	if c.credentialStore != nil {
+		creds, err := c.credentialStore.Load(ctx, c.address, nil)
+		if err != nil && !errors.Is(err, ErrCredentialsNotFound) {
+			return fmt.Errorf("load API credentials: %w", err)
+		}
+		if err == nil && creds.Key != staleKey {
+			c.SetAPICredentials(creds.Key, creds.Secret, creds.Passphrase)
+			c.pipeline.logger.LogAttrs(ctx, slog.LevelInfo, "polymarket credentials reloaded",
+				slog.String("api_key", MaskSecret(creds.Key)))
+			return nil
+		}
+	}
+
+	if c.refresher == nil {
+		return fmt.Errorf("no credential refresher configured")
+	}
+	creds, err := c.refresher(ctx)
+	if err != nil {
+		return err
+	}
+	// The AuthAPI refresher already sets and saves the credentials it obtains
+	if c.GetAPIKey() != creds.Key {
+		if err := c.SaveAPICredentials(ctx, creds); err != nil {
+			c.pipeline.logger.LogAttrs(ctx, slog.LevelWarn, "polymarket credentials not saved",
+				slog.String("error", err.Error()))
+		}
+	}
+	c.pipeline.logger.LogAttrs(ctx, slog.LevelInfo, "polymarket credentials refreshed",
+		slog.String("api_key", MaskSecret(creds.Key)))
+	return nil
 }
 
 // GetAPISecret gets API secret
 func (c *Client) GetAPISecret() string {
-	return c.apiSecret
+	return c.creds.Load().Secret
 }
 
 // GetAPIPassphrase gets API passphrase
 func (c *Client) GetAPIPassphrase() string {
-	return c.apiPassphrase
+	return c.creds.Load().Passphrase
 }
 
-// GetPrivateKey gets private key
+// GetPrivateKey gets private key (empty when the client uses an external Signer)
+//
+// Deprecated: the raw key should not leave the signer, use GetSigner to sign with it. GetPrivateKey will be
+// removed in a future release.
 func (c *Client) GetPrivateKey() string {
 	return c.privateKey
 }
@@ -248,6 +607,16 @@ func (c *Client) GetChainID() int {
 	return c.chainID
 }
 
+// Network gets the network profile (chain and contracts) of the client
+func (c *Client) Network() *Network {
+	return c.network
+}
+
+// RPCURL gets the Polygon JSON-RPC endpoint used by on-chain helpers
+func (c *Client) RPCURL() string {
+	return c.rpcURL
+}
+
 // GetSignatureType gets signature type
 func (c *Client) GetSignatureType() SignatureType {
 	return c.signatureType
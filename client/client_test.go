@@ -0,0 +1,205 @@
+package client
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/mtt-labs/poly-market-sdk/auth"
+)
+
+// testPrivateKey well-known development key of testAddress
+const testPrivateKey = "ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80"
+
+const testAddress = "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"
+
+// newTestClient creates a client of the test key serving the CLOB, Gamma and Data APIs from baseURL,
+// without time sync or retries. opts adjust the config before the client is created.
+func newTestClient(t *testing.T, baseURL string, opts ...func(*Config)) *Client {
+	t.Helper()
+	config := &Config{
+		BaseURL:      baseURL,
+		GammaBaseURL: baseURL,
+		DataBaseURL:  baseURL,
+		PrivateKey:   testPrivateKey,
+		TimeSync:     &TimeSyncConfig{Disabled: true},
+		RetryPolicy:  NoRetryPolicy(),
+	}
+	for _, opt := range opts {
+		opt(config)
+	}
+	c, err := NewClient(config)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	return c
+}
+
+func TestDoWithL2CtxReadOnlySkipsTimeSync(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Write([]byte(`1700000000`))
+	}))
+	defer server.Close()
+
+	c, err := NewPublicClient(&Config{BaseURL: server.URL})
+	if err != nil {
+		t.Fatalf("NewPublicClient: %v", err)
+	}
+
+	_, err = c.DoWithL2Ctx(context.Background(), http.MethodGet, "/data/orders", nil)
+	if !errors.Is(err, ErrNoSigner) {
+		t.Fatalf("DoWithL2Ctx error = %v, want ErrNoSigner", err)
+	}
+	if n := requests.Load(); n != 0 {
+		t.Errorf("read-only client made %d requests, want 0", n)
+	}
+}
+
+// credentialSet returns a consistent key set numbered i
+func credentialSet(i int) Credentials {
+	n := strconv.Itoa(i)
+	return Credentials{
+		Key:        "key-" + n,
+		Secret:     base64.URLEncoding.EncodeToString([]byte("secret-" + n)),
+		Passphrase: "passphrase-" + n,
+	}
+}
+
+// checkConsistent reports an error unless the L2 headers were signed with a single credential set
+func checkConsistent(headers *auth.L2AuthHeaders, method, path, body string) error {
+	n, ok := strings.CutPrefix(headers.APIKey, "key-")
+	if !ok {
+		return fmt.Errorf("unexpected API key %q", headers.APIKey)
+	}
+	i, err := strconv.Atoi(n)
+	if err != nil {
+		return fmt.Errorf("unexpected API key %q", headers.APIKey)
+	}
+	set := credentialSet(i)
+	if headers.Passphrase != set.Passphrase {
+		return fmt.Errorf("key %s signed with passphrase %q", headers.APIKey, headers.Passphrase)
+	}
+
+	timestamp, err := strconv.ParseInt(headers.Timestamp, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid timestamp %q", headers.Timestamp)
+	}
+	want, err := auth.BuildL2AuthHeaders(headers.Address, method, path, body, timestamp, set.Key, set.Secret, set.Passphrase)
+	if err != nil {
+		return err
+	}
+	if headers.Signature != want.Signature {
+		return fmt.Errorf("key %s signed with another secret", headers.APIKey)
+	}
+	return nil
+}
+
+// TestConcurrentCredentialSwap checks the concurrency contract of Client, run it with go test -race:
+// credentials swapped while requests are signed never produce a mixed key, secret and passphrase
+func TestConcurrentCredentialSwap(t *testing.T) {
+	var inconsistent atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		headers := &auth.L2AuthHeaders{
+			Address:    r.Header.Get("POLY_ADDRESS"),
+			Signature:  r.Header.Get("POLY_SIGNATURE"),
+			Timestamp:  r.Header.Get("POLY_TIMESTAMP"),
+			APIKey:     r.Header.Get("POLY_API_KEY"),
+			Passphrase: r.Header.Get("POLY_PASSPHRASE"),
+		}
+		if err := checkConsistent(headers, r.Method, r.URL.Path, string(body)); err != nil {
+			inconsistent.Add(1)
+			t.Errorf("server: %v", err)
+		}
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	initial := credentialSet(0)
+	c := newTestClient(t, server.URL, func(config *Config) {
+		config.APIKey = initial.Key
+		config.APISecret = initial.Secret
+		config.APIPassphrase = initial.Passphrase
+		config.RateLimit = &RateLimitConfig{Disabled: true}
+	})
+
+	const (
+		swappers  = 4
+		signers   = 8
+		requests  = 4
+		perWorker = 200
+	)
+
+	ctx := context.Background()
+	var wg sync.WaitGroup
+	for w := 0; w < swappers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < perWorker; i++ {
+				set := credentialSet(w*perWorker + i)
+				if i%2 == 0 {
+					c.SetAPICredentials(set.Key, set.Secret, set.Passphrase)
+				} else if err := c.SaveAPICredentials(ctx, &set); err != nil {
+					t.Errorf("SaveAPICredentials: %v", err)
+				}
+			}
+		}(w)
+	}
+	for w := 0; w < signers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < perWorker; i++ {
+				headers, err := c.SignL2Headers(http.MethodPost, "/order", `{"a":1}`)
+				if err != nil {
+					t.Errorf("SignL2Headers: %v", err)
+					return
+				}
+				if err := checkConsistent(headers, http.MethodPost, "/order", `{"a":1}`); err != nil {
+					t.Errorf("SignL2Headers: %v", err)
+				}
+				if snapshot := c.APICredentials(); snapshot != credentialSet(keyIndex(t, snapshot.Key)) {
+					t.Errorf("APICredentials returned a mixed set: %v", &snapshot)
+				}
+			}
+		}()
+	}
+	for w := 0; w < requests; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < perWorker/4; i++ {
+				if _, err := c.DoWithL2Ctx(ctx, http.MethodPost, "/order", map[string]int{"i": i}); err != nil {
+					t.Errorf("DoWithL2Ctx: %v", err)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if n := inconsistent.Load(); n != 0 {
+		t.Errorf("%d requests carried a mixed credential set", n)
+	}
+}
+
+// keyIndex returns the number of a key of credentialSet
+func keyIndex(t *testing.T, key string) int {
+	i, err := strconv.Atoi(strings.TrimPrefix(key, "key-"))
+	if err != nil {
+		t.Errorf("unexpected API key %q", key)
+	}
+	return i
+}
@@ -0,0 +1,180 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// DefaultTimeSyncInterval default interval between two server time syncs
+const DefaultTimeSyncInterval = 5 * time.Minute
+
+// timeSyncRetryDelay delay before retrying a failed sync, so that an unreachable /time does not slow down every request
+const timeSyncRetryDelay = 30 * time.Second
+
+// TimeSyncConfig server time sync configuration
+// POLY_TIMESTAMP values and GTD expirations are computed from the CLOB server clock,
+// so that a drifting host clock does not get signatures rejected
+type TimeSyncConfig struct {
+	Disabled bool          // Use the local clock as is, never call GET /time
+	Interval time.Duration // Re-sync interval, default DefaultTimeSyncInterval
+}
+
+// clock local clock corrected by the offset measured against the CLOB server
+// It is safe for concurrent use
+type clock struct {
+	disabled bool
+	interval time.Duration
+	offset   atomic.Int64 // Server time minus local time, in nanoseconds
+	lastSync atomic.Int64 // Local time of the last successful sync, in Unix nanoseconds (0 = never)
+	nextSync atomic.Int64 // Local time of the next automatic sync, in Unix nanoseconds (0 = now)
+	mu       sync.Mutex   // Serializes syncs so that concurrent requests trigger a single GET /time
+}
+
+// newClock creates a clock from config, nil config uses the defaults
+func newClock(config *TimeSyncConfig) *clock {
+	c := &clock{interval: DefaultTimeSyncInterval}
+	if config != nil {
+		c.disabled = config.Disabled
+		if config.Interval > 0 {
+			c.interval = config.Interval
+		}
+	}
+	return c
+}
+
+// now returns the local time corrected by the measured offset
+func (c *clock) now() time.Time {
+	return time.Now().Add(time.Duration(c.offset.Load()))
+}
+
+// stale reports whether the offset should be measured again
+func (c *clock) stale() bool {
+	return time.Now().UnixNano() >= c.nextSync.Load()
+}
+
+// serverTimeResponse response of GET /time when returned as an object
+type serverTimeResponse struct {
+	Time json.Number `json:"time"`
+}
+
+// parseServerTime parses the GET /time response (Unix seconds, as a bare number or {"time": ...}, with or
+// without a fractional part). Also returns the resolution of the time: a second when the server truncated it.
+func parseServerTime(data []byte) (time.Time, time.Duration, error) {
+	raw := strings.Trim(strings.TrimSpace(string(data)), `"`)
+	if raw != "" && raw[0] == '{' {
+		var response serverTimeResponse
+		if err := json.Unmarshal(data, &response); err != nil {
+			return time.Time{}, 0, fmt.Errorf("unmarshal server time: %w", err)
+		}
+		raw = response.Time.String()
+	}
+
+	whole, fraction, fractional := strings.Cut(raw, ".")
+	seconds, err := strconv.ParseInt(whole, 10, 64)
+	if err != nil {
+		return time.Time{}, 0, fmt.Errorf("invalid server time %q: %w", raw, err)
+	}
+	if !fractional {
+		return time.Unix(seconds, 0), time.Second, nil
+	}
+
+	// Nanoseconds from the first 9 digits of the fraction
+	digits := (fraction + "000000000")[:9]
+	nanos, err := strconv.ParseUint(digits, 10, 64)
+	if err != nil || fraction == "" || seconds < 0 {
+		return time.Time{}, 0, fmt.Errorf("invalid server time %q", raw)
+	}
+	return time.Unix(seconds, int64(nanos)), 0, nil
+}
+
+// SyncTime measures the offset between the local clock and the CLOB server clock (GET /time)
+// and applies it to every later POLY_TIMESTAMP and GTD expiration. Returns the measured skew.
+// Reference: https://github.com/Polymarket/clob-client
+func (c *Client) SyncTime(ctx context.Context) (time.Duration, error) {
+	c.clock.mu.Lock()
+	defer c.clock.mu.Unlock()
+	return c.syncTime(ctx)
+}
+
+// syncTime measures the clock offset, the caller must hold clock.mu
+func (c *Client) syncTime(ctx context.Context) (time.Duration, error) {
+	start := time.Now()
+	data, err := c.GetCtx(ctx, "/time")
+	if err != nil {
+		return 0, fmt.Errorf("get server time: %w", err)
+	}
+	end := time.Now()
+
+	serverTime, resolution, err := parseServerTime(data)
+	if err != nil {
+		return 0, err
+	}
+
+	// The server answered roughly halfway through the round trip, a time truncated to the second
+	// is on average half a second behind
+	localMidpoint := start.Add(end.Sub(start) / 2)
+	offset := serverTime.Add(resolution / 2).Sub(localMidpoint)
+
+	c.clock.offset.Store(int64(offset))
+	c.clock.lastSync.Store(end.UnixNano())
+	c.clock.nextSync.Store(end.Add(c.clock.interval).UnixNano())
+
+	c.pipeline.logger.LogAttrs(ctx, slog.LevelDebug, "polymarket clock synced",
+		slog.Duration("skew", offset),
+		slog.Duration("round_trip", end.Sub(start)),
+	)
+
+	return offset, nil
+}
+
+// EnsureTimeSync syncs the clock with the server when time sync is enabled and the last sync is
+// older than the sync interval. A failed sync is logged and the previous offset is kept, so that
+// requests still go out with the best known timestamp.
+func (c *Client) EnsureTimeSync(ctx context.Context) {
+	if c.clock.disabled || !c.clock.stale() {
+		return
+	}
+
+	c.clock.mu.Lock()
+	defer c.clock.mu.Unlock()
+
+	// Another request may have synced while we were waiting for the lock
+	if !c.clock.stale() {
+		return
+	}
+
+	if _, err := c.syncTime(ctx); err != nil {
+		c.clock.nextSync.Store(time.Now().Add(min(timeSyncRetryDelay, c.clock.interval)).UnixNano())
+		c.pipeline.logger.LogAttrs(ctx, slog.LevelWarn, "polymarket clock sync failed",
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+// Now returns the current time according to the CLOB server clock
+// (the local clock until the first sync)
+func (c *Client) Now() time.Time {
+	return c.clock.now()
+}
+
+// ClockSkew returns the last measured offset of the server clock relative to the local clock
+// A positive value means the local clock is behind the server
+func (c *Client) ClockSkew() time.Duration {
+	return time.Duration(c.clock.offset.Load())
+}
+
+// LastTimeSync returns when the clock was last synced with the server (zero if never)
+func (c *Client) LastTimeSync() time.Time {
+	last := c.clock.lastSync.Load()
+	if last == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, last)
+}
@@ -0,0 +1,184 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestParseServerTime(t *testing.T) {
+	tests := []struct {
+		data           string
+		want           time.Time
+		wantResolution time.Duration
+	}{
+		{"1700000000", time.Unix(1700000000, 0), time.Second},
+		{" 1700000000\n", time.Unix(1700000000, 0), time.Second},
+		{`"1700000000"`, time.Unix(1700000000, 0), time.Second},
+		{"1700000000.25", time.Unix(1700000000, 250_000_000), 0},
+		{`"1700000000.123456789123"`, time.Unix(1700000000, 123_456_789), 0},
+		{`{"time":1700000000}`, time.Unix(1700000000, 0), time.Second},
+		{`{"time":"1700000000.5"}`, time.Unix(1700000000, 500_000_000), 0},
+	}
+	for _, tt := range tests {
+		got, resolution, err := parseServerTime([]byte(tt.data))
+		if err != nil || !got.Equal(tt.want) || resolution != tt.wantResolution {
+			t.Errorf("parseServerTime(%q) = %v, %v, %v, want %v, %v", tt.data, got, resolution, err, tt.want, tt.wantResolution)
+		}
+	}
+
+	for _, data := range []string{"", "now", "1700000000.", "1700000000.5x", "-1.5", `{"time":"soon"}`, `{"time":`} {
+		if _, _, err := parseServerTime([]byte(data)); err == nil {
+			t.Errorf("parseServerTime(%q) expected an error", data)
+		}
+	}
+}
+
+// skewedServer serves GET /time from a clock skew ahead of the local clock, and records the
+// POLY_TIMESTAMP of the other requests
+type skewedServer struct {
+	*httptest.Server
+	syncs      atomic.Int32
+	mu         sync.Mutex
+	timestamps []int64
+}
+
+func newSkewedServer(t *testing.T, skew time.Duration, fractional bool) *skewedServer {
+	t.Helper()
+	s := &skewedServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		serverTime := time.Now().Add(skew)
+		if r.URL.Path == "/time" {
+			s.syncs.Add(1)
+			if fractional {
+				fmt.Fprintf(w, "%d.%09d", serverTime.Unix(), serverTime.Nanosecond())
+			} else {
+				fmt.Fprint(w, serverTime.Unix())
+			}
+			return
+		}
+		timestamp, err := strconv.ParseInt(r.Header.Get("POLY_TIMESTAMP"), 10, 64)
+		if err != nil {
+			t.Errorf("%s: invalid POLY_TIMESTAMP %q", r.URL.Path, r.Header.Get("POLY_TIMESTAMP"))
+		}
+		s.mu.Lock()
+		s.timestamps = append(s.timestamps, timestamp-serverTime.Unix())
+		s.mu.Unlock()
+		w.Write([]byte(`[]`))
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// withTimeSync enables the server time sync disabled by newTestClient
+func withTimeSync(config *Config) {
+	config.TimeSync = &TimeSyncConfig{}
+}
+
+func TestSyncTimeSkewedServer(t *testing.T) {
+	skew := -time.Hour
+	tests := []struct {
+		name       string
+		fractional bool
+		tolerance  time.Duration
+	}{
+		{"integer seconds", false, 600 * time.Millisecond},
+		{"fractional seconds", true, 100 * time.Millisecond},
+	}
+	for _, tt := range tests {
+		server := newSkewedServer(t, skew, tt.fractional)
+		c := newTestClient(t, server.URL, withTimeSync)
+
+		offset, err := c.SyncTime(context.Background())
+		if err != nil {
+			t.Fatalf("%s: SyncTime: %v", tt.name, err)
+		}
+		if diff := (offset - skew).Abs(); diff > tt.tolerance {
+			t.Errorf("%s: offset %v, want %v ± %v", tt.name, offset, skew, tt.tolerance)
+		}
+		if c.ClockSkew() != offset || c.LastTimeSync().IsZero() {
+			t.Errorf("%s: ClockSkew %v and LastTimeSync %v not updated", tt.name, c.ClockSkew(), c.LastTimeSync())
+		}
+		if diff := c.Now().Sub(time.Now().Add(skew)).Abs(); diff > tt.tolerance {
+			t.Errorf("%s: Now() is %v off the server clock", tt.name, diff)
+		}
+	}
+}
+
+func TestSignedTimestampsUseServerClock(t *testing.T) {
+	server := newSkewedServer(t, 2*time.Hour, false)
+	creds := credentialSet(1)
+	c := newTestClient(t, server.URL, withTimeSync, func(config *Config) {
+		config.APIKey, config.APISecret, config.APIPassphrase = creds.Key, creds.Secret, creds.Passphrase
+	})
+	ctx := context.Background()
+
+	// L2 requests sync the clock once per interval
+	for range 3 {
+		if _, err := c.DoWithL2Ctx(ctx, http.MethodGet, "/data/orders", nil); err != nil {
+			t.Fatalf("DoWithL2Ctx: %v", err)
+		}
+	}
+	if n := server.syncs.Load(); n != 1 {
+		t.Errorf("%d time syncs, want 1", n)
+	}
+
+	// L1 headers signed with the synced clock
+	l1Headers, err := c.GetSigner().SignL1Auth(c.GetChainID(), c.GetAddress(), c.Now().Unix(), nil)
+	if err != nil {
+		t.Fatalf("SignL1Auth: %v", err)
+	}
+	if _, err := c.GetWithL1Ctx(ctx, "/auth/derive-api-key", l1Headers); err != nil {
+		t.Fatalf("GetWithL1Ctx: %v", err)
+	}
+
+	if len(server.timestamps) != 4 {
+		t.Fatalf("%d signed requests, want 4", len(server.timestamps))
+	}
+	for i, diff := range server.timestamps {
+		// Within a second of the server clock, not 2 hours behind it
+		if diff < -1 || diff > 1 {
+			t.Errorf("request %d: POLY_TIMESTAMP %ds off the server clock", i+1, diff)
+		}
+	}
+}
+
+func TestEnsureTimeSyncKeepsOffsetOnFailure(t *testing.T) {
+	var fail atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if fail.Load() {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, time.Now().Add(time.Hour).Unix())
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL, func(config *Config) {
+		config.TimeSync = &TimeSyncConfig{Interval: time.Nanosecond}
+	})
+	c.EnsureTimeSync(context.Background())
+	skew := c.ClockSkew()
+	if skew < 59*time.Minute {
+		t.Fatalf("skew %v, want about an hour", skew)
+	}
+
+	fail.Store(true)
+	c.EnsureTimeSync(context.Background())
+	if c.ClockSkew() != skew {
+		t.Errorf("failed sync changed the skew to %v", c.ClockSkew())
+	}
+
+	// Disabled sync never calls the server
+	disabled := newTestClient(t, server.URL)
+	disabled.EnsureTimeSync(context.Background())
+	if disabled.ClockSkew() != 0 || !disabled.LastTimeSync().IsZero() {
+		t.Error("disabled time sync synced the clock")
+	}
+}
@@ -0,0 +1,188 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ErrCredentialsNotFound is returned by CredentialStore.Load when no credentials are stored for an address and nonce
+var ErrCredentialsNotFound = errors.New("API credentials not found")
+
+// Credentials API credentials (L2 authentication)
+type Credentials struct {
+	Key        string `json:"key"`
+	Secret     string `json:"secret"`
+	Passphrase string `json:"passphrase"`
+}
+
+// LogValue implements slog.LogValuer, the secret and passphrase are redacted
+func (c *Credentials) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.String("key", MaskSecret(c.Key)),
+		slog.String("secret", Redacted),
+		slog.String("passphrase", Redacted),
+	)
+}
+
+// String implements fmt.Stringer without exposing the secret and passphrase
+func (c *Credentials) String() string {
+	return fmt.Sprintf("APICredentials{key: %s}", MaskSecret(c.Key))
+}
+
+// GoString implements fmt.GoStringer without exposing the secret and passphrase
+func (c *Credentials) GoString() string {
+	return c.String()
+}
+
+// valid reports whether every field is set
+func (c *Credentials) valid() bool {
+	return c != nil && c.Key != "" && c.Secret != "" && c.Passphrase != ""
+}
+
+// CredentialStore persists API credentials per wallet address and L1 nonce
+//
+// Each L1 nonce holds a separate key set, a nil nonce is the default nonce 0. The client trades with
+// the default nonce credentials: it loads them from the store on startup (unless Config.APIKey is set),
+// saves them after they are created or derived, and reloads them on authentication errors when
+// Config.AutoRefreshCredentials is enabled. Other nonces are only stored when saved explicitly.
+// Implementations must be safe for concurrent use.
+type CredentialStore interface {
+	// Load returns the credentials of an address and nonce, or ErrCredentialsNotFound
+	Load(ctx context.Context, address string, nonce *big.Int) (*Credentials, error)
+	// Save stores the credentials of an address and nonce, replacing previous ones
+	Save(ctx context.Context, address string, nonce *big.Int, creds *Credentials) error
+	// Delete removes the credentials of an address and nonce, deleting missing credentials is not an error
+	Delete(ctx context.Context, address string, nonce *big.Int) error
+}
+
+// storeKey normalizes an address and nonce used as a store key
+// The default nonce key is the bare address, other nonces are suffixed with "/<nonce>"
+func storeKey(address string, nonce *big.Int) string {
+	if common.IsHexAddress(address) {
+		address = common.HexToAddress(address).Hex()
+	}
+	if nonce == nil || nonce.Sign() == 0 {
+		return address
+	}
+	return address + "/" + nonce.String()
+}
+
+// MemoryCredentialStore in-memory credential store, credentials are lost when the process exits
+type MemoryCredentialStore struct {
+	mu    sync.RWMutex
+	creds map[string]Credentials
+}
+
+// NewMemoryCredentialStore creates an in-memory credential store
+func NewMemoryCredentialStore() *MemoryCredentialStore {
+	return &MemoryCredentialStore{creds: make(map[string]Credentials)}
+}
+
+// Load returns the credentials of an address and nonce
+func (s *MemoryCredentialStore) Load(_ context.Context, address string, nonce *big.Int) (*Credentials, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	creds, ok := s.creds[storeKey(address, nonce)]
+	if !ok {
+		return nil, ErrCredentialsNotFound
+	}
+	return &creds, nil
+}
+
+// Save stores the credentials of an address and nonce
+func (s *MemoryCredentialStore) Save(_ context.Context, address string, nonce *big.Int, creds *Credentials) error {
+	if creds == nil {
+		return fmt.Errorf("credentials are required")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.creds[storeKey(address, nonce)] = *creds
+	return nil
+}
+
+// Delete removes the credentials of an address and nonce
+func (s *MemoryCredentialStore) Delete(_ context.Context, address string, nonce *big.Int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.creds, storeKey(address, nonce))
+	return nil
+}
+
+// DefaultCredentialEnvPrefix default prefix of the credential environment variables
+const DefaultCredentialEnvPrefix = "POLYMARKET_"
+
+// EnvCredentialStore credential store backed by environment variables
+// <Prefix>API_KEY, <Prefix>API_SECRET and <Prefix>API_PASSPHRASE hold the default nonce credentials of
+// any address, which suits deployments where a secret manager injects them. The credentials of nonce N
+// use the same names suffixed with _N (<Prefix>API_KEY_1). Save and Delete only change the environment
+// of the current process.
+type EnvCredentialStore struct {
+	Prefix string // Variable prefix, default DefaultCredentialEnvPrefix
+}
+
+// NewEnvCredentialStore creates an environment credential store, empty prefix uses DefaultCredentialEnvPrefix
+func NewEnvCredentialStore(prefix string) *EnvCredentialStore {
+	return &EnvCredentialStore{Prefix: prefix}
+}
+
+// names returns the names of the key, secret and passphrase variables of a nonce
+func (s *EnvCredentialStore) names(nonce *big.Int) (key, secret, passphrase string) {
+	prefix := s.Prefix
+	if prefix == "" {
+		prefix = DefaultCredentialEnvPrefix
+	}
+	prefix = strings.ToUpper(prefix)
+
+	suffix := ""
+	if nonce != nil && nonce.Sign() != 0 {
+		suffix = "_" + nonce.String()
+	}
+	return prefix + "API_KEY" + suffix, prefix + "API_SECRET" + suffix, prefix + "API_PASSPHRASE" + suffix
+}
+
+// Load returns the credentials of a nonce from the environment
+func (s *EnvCredentialStore) Load(_ context.Context, _ string, nonce *big.Int) (*Credentials, error) {
+	keyName, secretName, passphraseName := s.names(nonce)
+	creds := &Credentials{
+		Key:        os.Getenv(keyName),
+		Secret:     os.Getenv(secretName),
+		Passphrase: os.Getenv(passphraseName),
+	}
+	if !creds.valid() {
+		return nil, ErrCredentialsNotFound
+	}
+	return creds, nil
+}
+
+// Save sets the credentials of a nonce in the process environment
+func (s *EnvCredentialStore) Save(_ context.Context, _ string, nonce *big.Int, creds *Credentials) error {
+	if creds == nil {
+		return fmt.Errorf("credentials are required")
+	}
+	keyName, secretName, passphraseName := s.names(nonce)
+	for name, value := range map[string]string{keyName: creds.Key, secretName: creds.Secret, passphraseName: creds.Passphrase} {
+		if err := os.Setenv(name, value); err != nil {
+			return fmt.Errorf("set %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// Delete unsets the credentials of a nonce in the process environment
+func (s *EnvCredentialStore) Delete(_ context.Context, _ string, nonce *big.Int) error {
+	keyName, secretName, passphraseName := s.names(nonce)
+	for _, name := range []string{keyName, secretName, passphraseName} {
+		if err := os.Unsetenv(name); err != nil {
+			return fmt.Errorf("unset %s: %w", name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,215 @@
+package client
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+// scrypt parameters of the credential file key derivation
+const (
+	credentialFileVersion = 1
+	credentialScryptN     = 1 << 15
+	credentialScryptR     = 8
+	credentialScryptP     = 1
+	credentialKeyLength   = 32 // AES-256
+	credentialSaltLength  = 16
+)
+
+// FileCredentialStore credential store persisting credentials in a file encrypted with a passphrase
+// (scrypt key derivation, AES-256-GCM). The file holds the credentials of every address and nonce
+// and is rewritten atomically with 0600 permissions.
+type FileCredentialStore struct {
+	path       string
+	passphrase []byte
+	mu         sync.Mutex
+}
+
+// credentialFile on-disk format of the encrypted credential file
+type credentialFile struct {
+	Version    int    `json:"version"`
+	Salt       []byte `json:"salt"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// NewFileCredentialStore creates an encrypted file credential store
+// The file is created on the first Save
+func NewFileCredentialStore(path, passphrase string) (*FileCredentialStore, error) {
+	if path == "" {
+		return nil, fmt.Errorf("credential file path is required")
+	}
+	if passphrase == "" {
+		return nil, fmt.Errorf("credential file passphrase is required")
+	}
+
+	return &FileCredentialStore{
+		path:       path,
+		passphrase: []byte(passphrase),
+	}, nil
+}
+
+// Load returns the credentials of an address and nonce
+func (s *FileCredentialStore) Load(_ context.Context, address string, nonce *big.Int) (*Credentials, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	all, err := s.read()
+	if err != nil {
+		return nil, err
+	}
+	creds, ok := all[storeKey(address, nonce)]
+	if !ok {
+		return nil, ErrCredentialsNotFound
+	}
+	return &creds, nil
+}
+
+// Save stores the credentials of an address and nonce
+func (s *FileCredentialStore) Save(_ context.Context, address string, nonce *big.Int, creds *Credentials) error {
+	if creds == nil {
+		return fmt.Errorf("credentials are required")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	all, err := s.read()
+	if err != nil {
+		return err
+	}
+	all[storeKey(address, nonce)] = *creds
+	return s.write(all)
+}
+
+// Delete removes the credentials of an address and nonce
+func (s *FileCredentialStore) Delete(_ context.Context, address string, nonce *big.Int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	all, err := s.read()
+	if err != nil {
+		return err
+	}
+	key := storeKey(address, nonce)
+	if _, ok := all[key]; !ok {
+		return nil
+	}
+	delete(all, key)
+	return s.write(all)
+}
+
+// read decrypts the credential file, a missing file holds no credentials
+func (s *FileCredentialStore) read() (map[string]Credentials, error) {
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return make(map[string]Credentials), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read credential file: %w", err)
+	}
+
+	var file credentialFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("unmarshal credential file: %w", err)
+	}
+	if file.Version != credentialFileVersion {
+		return nil, fmt.Errorf("unsupported credential file version: %d", file.Version)
+	}
+
+	aead, err := s.cipher(file.Salt)
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := aead.Open(nil, file.Nonce, file.Ciphertext, nil)
+	if err != nil {
+		return nil, fmt.Errorf("decrypt credential file (wrong passphrase?): %w", err)
+	}
+
+	all := make(map[string]Credentials)
+	if err := json.Unmarshal(plaintext, &all); err != nil {
+		return nil, fmt.Errorf("unmarshal credentials: %w", err)
+	}
+	return all, nil
+}
+
+// write encrypts the credentials with a fresh salt and nonce and replaces the file atomically
+func (s *FileCredentialStore) write(all map[string]Credentials) error {
+	plaintext, err := json.Marshal(all)
+	if err != nil {
+		return fmt.Errorf("marshal credentials: %w", err)
+	}
+
+	salt := make([]byte, credentialSaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return fmt.Errorf("generate salt: %w", err)
+	}
+	aead, err := s.cipher(salt)
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return fmt.Errorf("generate nonce: %w", err)
+	}
+
+	data, err := json.Marshal(&credentialFile{
+		Version:    credentialFileVersion,
+		Salt:       salt,
+		Nonce:      nonce,
+		Ciphertext: aead.Seal(nil, nonce, plaintext, nil),
+	})
+	if err != nil {
+		return fmt.Errorf("marshal credential file: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("create credential file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := tmp.Chmod(0o600); err != nil {
+		tmp.Close()
+		return fmt.Errorf("chmod credential file: %w", err)
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("write credential file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("close credential file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return fmt.Errorf("replace credential file: %w", err)
+	}
+	return nil
+}
+
+// cipher derives the AES-GCM cipher of a salt from the passphrase
+func (s *FileCredentialStore) cipher(salt []byte) (cipher.AEAD, error) {
+	key, err := scrypt.Key(s.passphrase, salt, credentialScryptN, credentialScryptR, credentialScryptP, credentialKeyLength)
+	if err != nil {
+		return nil, fmt.Errorf("derive credential key: %w", err)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("create cipher: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("create GCM: %w", err)
+	}
+	return aead, nil
+}
@@ -0,0 +1,271 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+var (
+	oldCreds = &Credentials{Key: "key-old", Secret: "c2VjcmV0LW9sZA==", Passphrase: "passphrase-old"}
+	newCreds = &Credentials{Key: "key-new", Secret: "c2VjcmV0LW5ldw==", Passphrase: "passphrase-new"}
+)
+
+func TestCredentialStores(t *testing.T) {
+	stores := []struct {
+		name       string
+		store      func(t *testing.T) CredentialStore
+		perAddress bool // Whether the store separates addresses
+	}{
+		{"memory", func(t *testing.T) CredentialStore { return NewMemoryCredentialStore() }, true},
+		{"file", func(t *testing.T) CredentialStore {
+			store, err := NewFileCredentialStore(filepath.Join(t.TempDir(), "credentials.json"), "test-passphrase")
+			if err != nil {
+				t.Fatalf("NewFileCredentialStore: %v", err)
+			}
+			return store
+		}, true},
+		{"env", func(t *testing.T) CredentialStore {
+			// Register the variables for restoration, then start from an empty environment
+			for _, suffix := range []string{"", "_1"} {
+				for _, name := range []string{"API_KEY", "API_SECRET", "API_PASSPHRASE"} {
+					t.Setenv("POLYTEST_"+name+suffix, "")
+					os.Unsetenv("POLYTEST_" + name + suffix)
+				}
+			}
+			return NewEnvCredentialStore("polytest_")
+		}, false},
+	}
+
+	for _, tt := range stores {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			store := tt.store(t)
+
+			if _, err := store.Load(ctx, testAddress, nil); !errors.Is(err, ErrCredentialsNotFound) {
+				t.Fatalf("Load of an empty store error = %v, want ErrCredentialsNotFound", err)
+			}
+
+			if err := store.Save(ctx, testAddress, nil, oldCreds); err != nil {
+				t.Fatalf("Save: %v", err)
+			}
+			if err := store.Save(ctx, testAddress, big.NewInt(1), newCreds); err != nil {
+				t.Fatalf("Save nonce 1: %v", err)
+			}
+
+			// A nil nonce is the default nonce 0, and addresses are case-insensitive
+			got, err := store.Load(ctx, strings.ToLower(testAddress), big.NewInt(0))
+			if err != nil || *got != *oldCreds {
+				t.Errorf("Load nonce 0 = %v, %v, want %v", got, err, oldCreds)
+			}
+			got, err = store.Load(ctx, testAddress, big.NewInt(1))
+			if err != nil || *got != *newCreds {
+				t.Errorf("Load nonce 1 = %v, %v, want %v", got, err, newCreds)
+			}
+			if _, err := store.Load(ctx, testAddress, big.NewInt(2)); !errors.Is(err, ErrCredentialsNotFound) {
+				t.Errorf("Load nonce 2 error = %v, want ErrCredentialsNotFound", err)
+			}
+			if tt.perAddress {
+				other := "0x70997970C51812dc3A010C7d01b50e0d17dc79C8"
+				if _, err := store.Load(ctx, other, nil); !errors.Is(err, ErrCredentialsNotFound) {
+					t.Errorf("Load of another address error = %v, want ErrCredentialsNotFound", err)
+				}
+			}
+
+			if err := store.Delete(ctx, testAddress, big.NewInt(1)); err != nil {
+				t.Fatalf("Delete nonce 1: %v", err)
+			}
+			if _, err := store.Load(ctx, testAddress, big.NewInt(1)); !errors.Is(err, ErrCredentialsNotFound) {
+				t.Errorf("Load of a deleted nonce error = %v, want ErrCredentialsNotFound", err)
+			}
+			if got, err := store.Load(ctx, testAddress, nil); err != nil || *got != *oldCreds {
+				t.Errorf("Load nonce 0 after deleting nonce 1 = %v, %v, want %v", got, err, oldCreds)
+			}
+			if err := store.Delete(ctx, testAddress, big.NewInt(5)); err != nil {
+				t.Errorf("Delete of missing credentials: %v", err)
+			}
+			if err := store.Save(ctx, testAddress, nil, nil); err == nil {
+				t.Error("Save of nil credentials expected an error")
+			}
+		})
+	}
+}
+
+func TestFileCredentialStorePersistence(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "credentials.json")
+
+	store, err := NewFileCredentialStore(path, "test-passphrase")
+	if err != nil {
+		t.Fatalf("NewFileCredentialStore: %v", err)
+	}
+	if err := store.Save(ctx, testAddress, nil, oldCreds); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("Stat: %v", err)
+	}
+	if perm := info.Mode().Perm(); perm != 0o600 {
+		t.Errorf("file permissions = %o, want 600", perm)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+	if strings.Contains(string(data), oldCreds.Passphrase) {
+		t.Error("credential file holds the passphrase in clear text")
+	}
+
+	reopened, _ := NewFileCredentialStore(path, "test-passphrase")
+	if got, err := reopened.Load(ctx, testAddress, nil); err != nil || *got != *oldCreds {
+		t.Errorf("Load after reopening = %v, %v, want %v", got, err, oldCreds)
+	}
+
+	wrong, _ := NewFileCredentialStore(path, "wrong-passphrase")
+	if _, err := wrong.Load(ctx, testAddress, nil); err == nil || errors.Is(err, ErrCredentialsNotFound) {
+		t.Errorf("Load with a wrong passphrase error = %v, want a decryption error", err)
+	}
+}
+
+// newAuthServer serves L2 requests, rejecting every API key but the valid one with status
+func newAuthServer(t *testing.T, valid string, status int, requests *atomic.Int32) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.Header.Get("POLY_API_KEY") != valid {
+			w.WriteHeader(status)
+			w.Write([]byte(`{"error":"Unauthorized/Invalid api key"}`))
+			return
+		}
+		w.Write([]byte(`[]`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func newRefreshClient(t *testing.T, baseURL string, store CredentialStore, autoRefresh bool) *Client {
+	t.Helper()
+	return newTestClient(t, baseURL, func(config *Config) {
+		config.APIKey = oldCreds.Key
+		config.APISecret = oldCreds.Secret
+		config.APIPassphrase = oldCreds.Passphrase
+		config.CredentialStore = store
+		config.AutoRefreshCredentials = autoRefresh
+	})
+}
+
+func TestAutoRefreshReloadsStoredCredentials(t *testing.T) {
+	var requests atomic.Int32
+	server := newAuthServer(t, newCreds.Key, http.StatusUnauthorized, &requests)
+
+	// Another process rotated the key and stored the new one
+	store := NewMemoryCredentialStore()
+	c := newRefreshClient(t, server.URL, store, true)
+	if err := store.Save(context.Background(), testAddress, nil, newCreds); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	c.SetCredentialRefresher(func(ctx context.Context) (*Credentials, error) {
+		t.Error("refresher called although the store holds newer credentials")
+		return nil, errors.New("unexpected refresh")
+	})
+
+	if _, err := c.DoWithL2Ctx(context.Background(), http.MethodGet, "/data/orders", nil); err != nil {
+		t.Fatalf("DoWithL2Ctx: %v", err)
+	}
+	if got := c.APICredentials(); got != *newCreds {
+		t.Errorf("client credentials = %v, want %v", &got, newCreds)
+	}
+	if n := requests.Load(); n != 2 {
+		t.Errorf("server received %d requests, want 2", n)
+	}
+}
+
+func TestAutoRefreshDerivesAndSavesCredentials(t *testing.T) {
+	var requests atomic.Int32
+	server := newAuthServer(t, newCreds.Key, http.StatusForbidden, &requests)
+
+	store := NewMemoryCredentialStore()
+	c := newRefreshClient(t, server.URL, store, true)
+	var refreshes atomic.Int32
+	c.SetCredentialRefresher(func(ctx context.Context) (*Credentials, error) {
+		refreshes.Add(1)
+		return newCreds, nil
+	})
+
+	if _, err := c.DoWithL2Ctx(context.Background(), http.MethodGet, "/data/orders", nil); err != nil {
+		t.Fatalf("DoWithL2Ctx: %v", err)
+	}
+	if n := refreshes.Load(); n != 1 {
+		t.Errorf("refresher called %d times, want 1", n)
+	}
+	if got := c.GetAPIKey(); got != newCreds.Key {
+		t.Errorf("client API key = %q, want %q", got, newCreds.Key)
+	}
+	stored, err := store.Load(context.Background(), testAddress, nil)
+	if err != nil || *stored != *newCreds {
+		t.Errorf("stored credentials = %v, %v, want %v", stored, err, newCreds)
+	}
+}
+
+func TestAutoRefreshFailureKeepsAuthError(t *testing.T) {
+	var requests atomic.Int32
+	server := newAuthServer(t, newCreds.Key, http.StatusUnauthorized, &requests)
+
+	c := newRefreshClient(t, server.URL, nil, true)
+	c.SetCredentialRefresher(func(ctx context.Context) (*Credentials, error) {
+		return nil, errors.New("derive failed")
+	})
+
+	_, err := c.DoWithL2Ctx(context.Background(), http.MethodGet, "/data/orders", nil)
+	if !IsAuth(err) || !strings.Contains(err.Error(), "derive failed") {
+		t.Errorf("DoWithL2Ctx error = %v, want the auth error with the refresh failure", err)
+	}
+	if got := c.GetAPIKey(); got != oldCreds.Key {
+		t.Errorf("client API key = %q, want %q", got, oldCreds.Key)
+	}
+}
+
+func TestAutoRefreshDisabled(t *testing.T) {
+	var requests atomic.Int32
+	server := newAuthServer(t, newCreds.Key, http.StatusUnauthorized, &requests)
+
+	store := NewMemoryCredentialStore()
+	c := newRefreshClient(t, server.URL, store, false)
+	if err := store.Save(context.Background(), testAddress, nil, newCreds); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	if _, err := c.DoWithL2Ctx(context.Background(), http.MethodGet, "/data/orders", nil); !IsAuth(err) {
+		t.Errorf("DoWithL2Ctx error = %v, want an auth error", err)
+	}
+	if n := requests.Load(); n != 1 {
+		t.Errorf("server received %d requests, want 1", n)
+	}
+	if got := c.GetAPIKey(); got != oldCreds.Key {
+		t.Errorf("client API key = %q, want %q", got, oldCreds.Key)
+	}
+}
+
+func TestNewClientLoadsDefaultNonceCredentials(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryCredentialStore()
+	store.Save(ctx, testAddress, nil, oldCreds)
+	store.Save(ctx, testAddress, big.NewInt(1), newCreds)
+
+	c, err := NewClient(&Config{PrivateKey: testPrivateKey, CredentialStore: store})
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	if got := c.APICredentials(); got != *oldCreds {
+		t.Errorf("client credentials = %v, want %v", &got, oldCreds)
+	}
+}
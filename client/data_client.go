@@ -0,0 +1,33 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// DefaultDataBaseURL default Data API address
+const DefaultDataBaseURL = "https://data-api.polymarket.com"
+
+// DataClient is a simple HTTP client for Data API (read-only, no authentication required)
+// Reference: https://docs.polymarket.com/developers/misc-endpoints/data-api-get-positions
+type DataClient struct {
+	pipeline *pipeline
+}
+
+// Get executes GET request to Data API
+func (c *DataClient) Get(endpoint string) ([]byte, error) {
+	return c.GetCtx(context.Background(), endpoint)
+}
+
+// GetCtx executes GET request to Data API bound to ctx
+func (c *DataClient) GetCtx(ctx context.Context, endpoint string) ([]byte, error) {
+	return c.pipeline.do(ctx, &request{
+		method:   http.MethodGet,
+		endpoint: endpoint,
+	})
+}
+
+// BaseURL gets the Data API base URL
+func (c *DataClient) BaseURL() string {
+	return c.pipeline.baseURL
+}
@@ -0,0 +1,173 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Polymarket error codes
+// Reference: https://docs.polymarket.com/developers/CLOB/orders/create-order
+const (
+	ErrorCodeInvalidOrderMinTickSize      = "INVALID_ORDER_MIN_TICK_SIZE"
+	ErrorCodeInvalidOrderMinSize          = "INVALID_ORDER_MIN_SIZE"
+	ErrorCodeInvalidOrderDuplicated       = "INVALID_ORDER_DUPLICATED"
+	ErrorCodeInvalidOrderNotEnoughBalance = "INVALID_ORDER_NOT_ENOUGH_BALANCE"
+	ErrorCodeInvalidOrderExpiration       = "INVALID_ORDER_EXPIRATION"
+	ErrorCodeInvalidOrderError            = "INVALID_ORDER_ERROR"
+	ErrorCodeExecutionError               = "EXECUTION_ERROR"
+	ErrorCodeOrderDelayed                 = "ORDER_DELAYED"
+	ErrorCodeDelayingOrderError           = "DELAYING_ORDER_ERROR"
+	ErrorCodeFOKOrderNotFilled            = "FOK_ORDER_NOT_FILLED_ERROR"
+	ErrorCodeMarketNotReady               = "MARKET_NOT_READY"
+)
+
+// ErrNoSigner is returned by authenticated operations on a read-only client
+var ErrNoSigner = errors.New("no signer configured: client is read-only")
+
+// Sentinel errors for classifying API errors with errors.Is
+var (
+	ErrRateLimited         = errors.New("rate limited")
+	ErrAuth                = errors.New("authentication failed")
+	ErrInsufficientBalance = errors.New("insufficient balance or allowance")
+	ErrTickSizeViolation   = errors.New("price breaks minimum tick size")
+	ErrMarketUnavailable   = errors.New("market not accepting orders")
+)
+
+// APIError is returned when Polymarket rejects a request
+// Use errors.As to inspect it, or the Is* helpers to classify it
+type APIError struct {
+	StatusCode int    // HTTP status code (200 when the error was reported in a successful response body)
+	Method     string // HTTP method of the request
+	Endpoint   string // Request endpoint, including the query string
+	Message    string // Error message parsed from the response body
+	Code       string // Polymarket error code (e.g. INVALID_ORDER_MIN_TICK_SIZE), if any
+	Body       string // Raw response body
+}
+
+// Error implements the error interface
+func (e *APIError) Error() string {
+	msg := e.Message
+	if msg == "" {
+		msg = e.Body
+	}
+	if e.Code != "" && !strings.HasPrefix(msg, e.Code) {
+		msg = e.Code + ": " + msg
+	}
+	return fmt.Sprintf("API error: %s %s: status %d: %s", e.Method, e.Endpoint, e.StatusCode, msg)
+}
+
+// Is reports whether the error matches one of the sentinel errors
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrAuth:
+		return e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden
+	case ErrInsufficientBalance:
+		return e.Code == ErrorCodeInvalidOrderNotEnoughBalance ||
+			e.messageContains("not enough balance", "insufficient balance", "allowance")
+	case ErrTickSizeViolation:
+		return e.Code == ErrorCodeInvalidOrderMinTickSize ||
+			e.messageContains("tick size")
+	case ErrMarketUnavailable:
+		return e.Code == ErrorCodeMarketNotReady ||
+			e.messageContains("not accepting orders", "market is closed", "market closed")
+	}
+	return false
+}
+
+// messageContains reports whether the message contains any of the substrings (case-insensitive)
+func (e *APIError) messageContains(substrs ...string) bool {
+	msg := strings.ToLower(e.Message)
+	for _, s := range substrs {
+		if strings.Contains(msg, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// apiErrorBody possible shapes of Polymarket error responses
+type apiErrorBody struct {
+	Error     string `json:"error"`
+	ErrorMsg  string `json:"errorMsg"`
+	Message   string `json:"message"`
+	Code      string `json:"code"`
+	ErrorCode string `json:"errorCode"`
+}
+
+// NewAPIError builds an APIError from a response, parsing the Polymarket error message and code
+func NewAPIError(method, endpoint string, statusCode int, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: statusCode,
+		Method:     method,
+		Endpoint:   endpoint,
+		Body:       string(body),
+	}
+
+	var parsed apiErrorBody
+	if err := json.Unmarshal(body, &parsed); err == nil {
+		switch {
+		case parsed.Error != "":
+			apiErr.Message = parsed.Error
+		case parsed.ErrorMsg != "":
+			apiErr.Message = parsed.ErrorMsg
+		case parsed.Message != "":
+			apiErr.Message = parsed.Message
+		}
+		apiErr.Code = parsed.Code
+		if apiErr.Code == "" {
+			apiErr.Code = parsed.ErrorCode
+		}
+	} else {
+		apiErr.Message = strings.TrimSpace(string(body))
+	}
+
+	if apiErr.Code == "" {
+		apiErr.Code = errorCodeFromMessage(apiErr.Message)
+	}
+
+	return apiErr
+}
+
+// errorCodeFromMessage extracts a leading error code such as "INVALID_ORDER_MIN_SIZE: ..." from a message
+func errorCodeFromMessage(msg string) string {
+	code, _, found := strings.Cut(msg, ":")
+	if !found || code == "" {
+		return ""
+	}
+	for _, r := range code {
+		if (r < 'A' || r > 'Z') && r != '_' {
+			return ""
+		}
+	}
+	return code
+}
+
+// IsRateLimited reports whether err is an API error caused by rate limiting (HTTP 429)
+func IsRateLimited(err error) bool {
+	return errors.Is(err, ErrRateLimited)
+}
+
+// IsAuth reports whether err is an API error caused by missing or invalid credentials (HTTP 401/403)
+func IsAuth(err error) bool {
+	return errors.Is(err, ErrAuth)
+}
+
+// IsInsufficientBalance reports whether err is an order rejection caused by insufficient balance or allowance
+func IsInsufficientBalance(err error) bool {
+	return errors.Is(err, ErrInsufficientBalance)
+}
+
+// IsTickSizeViolation reports whether err is an order rejection caused by a price not aligned to the tick size
+func IsTickSizeViolation(err error) bool {
+	return errors.Is(err, ErrTickSizeViolation)
+}
+
+// IsMarketUnavailable reports whether err is an order rejection caused by a market not ready, paused or closed
+func IsMarketUnavailable(err error) bool {
+	return errors.Is(err, ErrMarketUnavailable)
+}
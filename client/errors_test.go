@@ -0,0 +1,107 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestNewAPIError(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      int
+		body        string
+		wantMessage string
+		wantCode    string
+	}{
+		{"error field", 400, `{"error":"invalid signature"}`, "invalid signature", ""},
+		{"errorMsg field", 200, `{"success":false,"errorMsg":"not enough balance / allowance"}`, "not enough balance / allowance", ""},
+		{"message field", 404, `{"message":"market not found"}`, "market not found", ""},
+		{"code field", 400, `{"error":"order too small","code":"INVALID_ORDER_MIN_SIZE"}`, "order too small", ErrorCodeInvalidOrderMinSize},
+		{"errorCode field", 400, `{"errorMsg":"not ready","errorCode":"MARKET_NOT_READY"}`, "not ready", ErrorCodeMarketNotReady},
+		{"code prefix of the message", 400, `{"error":"INVALID_ORDER_MIN_TICK_SIZE: price breaks tick size"}`, "INVALID_ORDER_MIN_TICK_SIZE: price breaks tick size", ErrorCodeInvalidOrderMinTickSize},
+		{"message with a colon", 400, `{"error":"Invalid order: bad price"}`, "Invalid order: bad price", ""},
+		{"plain text", 502, "Bad Gateway\n", "Bad Gateway", ""},
+		{"plain text with code", 400, "INVALID_ORDER_EXPIRATION: expired", "INVALID_ORDER_EXPIRATION: expired", ErrorCodeInvalidOrderExpiration},
+		{"empty body", 500, "", "", ""},
+	}
+	for _, tt := range tests {
+		err := NewAPIError(http.MethodPost, "/order", tt.status, []byte(tt.body))
+		if err.StatusCode != tt.status || err.Method != http.MethodPost || err.Endpoint != "/order" || err.Body != tt.body {
+			t.Errorf("%s: request fields = %+v", tt.name, err)
+		}
+		if err.Message != tt.wantMessage || err.Code != tt.wantCode {
+			t.Errorf("%s: message %q code %q, want %q and %q", tt.name, err.Message, err.Code, tt.wantMessage, tt.wantCode)
+		}
+	}
+}
+
+func TestAPIErrorString(t *testing.T) {
+	tests := []struct {
+		err  *APIError
+		want string
+	}{
+		{NewAPIError("POST", "/order", 400, []byte(`{"error":"order too small","code":"INVALID_ORDER_MIN_SIZE"}`)),
+			"API error: POST /order: status 400: INVALID_ORDER_MIN_SIZE: order too small"},
+		{NewAPIError("POST", "/order", 400, []byte(`{"error":"INVALID_ORDER_MIN_SIZE: order too small"}`)),
+			"API error: POST /order: status 400: INVALID_ORDER_MIN_SIZE: order too small"},
+		{NewAPIError("POST", "/order", 200, []byte(`{"success":false}`)),
+			`API error: POST /order: status 200: {"success":false}`},
+	}
+	for _, tt := range tests {
+		if got := tt.err.Error(); got != tt.want {
+			t.Errorf("Error() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestAPIErrorIs(t *testing.T) {
+	sentinels := []error{ErrRateLimited, ErrAuth, ErrInsufficientBalance, ErrTickSizeViolation, ErrMarketUnavailable}
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		want   error // Only sentinel matched, nil for none
+	}{
+		{"429", 429, `{"error":"too many requests"}`, ErrRateLimited},
+		{"401", 401, `{"error":"Unauthorized/Invalid api key"}`, ErrAuth},
+		{"403", 403, `{"error":"forbidden"}`, ErrAuth},
+		{"balance code", 400, `{"errorMsg":"rejected","errorCode":"INVALID_ORDER_NOT_ENOUGH_BALANCE"}`, ErrInsufficientBalance},
+		{"balance message", 200, `{"errorMsg":"not enough balance / allowance"}`, ErrInsufficientBalance},
+		{"insufficient balance message", 400, `{"error":"Insufficient balance"}`, ErrInsufficientBalance},
+		{"allowance message", 400, `{"error":"the allowance is too low"}`, ErrInsufficientBalance},
+		{"tick size code", 400, `{"error":"INVALID_ORDER_MIN_TICK_SIZE: bad price"}`, ErrTickSizeViolation},
+		{"tick size message", 400, `{"error":"price breaks minimum Tick Size rules"}`, ErrTickSizeViolation},
+		{"market not ready code", 400, `{"error":"MARKET_NOT_READY: wait"}`, ErrMarketUnavailable},
+		{"not accepting orders message", 400, `{"error":"the market is not accepting orders"}`, ErrMarketUnavailable},
+		{"closed market message", 400, `{"error":"market closed"}`, ErrMarketUnavailable},
+		{"other error", 400, `{"error":"invalid signature"}`, nil},
+		{"server error", 500, `{"error":"internal error"}`, nil},
+	}
+	for _, tt := range tests {
+		// Errors are classified through wrapping
+		err := fmt.Errorf("create order: %w", NewAPIError(http.MethodPost, "/order", tt.status, []byte(tt.body)))
+		for _, sentinel := range sentinels {
+			if got := errors.Is(err, sentinel); got != (sentinel == tt.want) {
+				t.Errorf("%s: errors.Is(%v) = %v", tt.name, sentinel, got)
+			}
+		}
+	}
+
+	helpers := map[string]func(error) bool{
+		"IsRateLimited":         IsRateLimited,
+		"IsAuth":                IsAuth,
+		"IsInsufficientBalance": IsInsufficientBalance,
+		"IsTickSizeViolation":   IsTickSizeViolation,
+		"IsMarketUnavailable":   IsMarketUnavailable,
+	}
+	for name, helper := range helpers {
+		if helper(errors.New("plain error")) || helper(nil) {
+			t.Errorf("%s matched an error that is not an APIError", name)
+		}
+	}
+	if !IsRateLimited(NewAPIError(http.MethodGet, "/book", 429, nil)) {
+		t.Error("IsRateLimited(429) = false")
+	}
+}
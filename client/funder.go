@@ -0,0 +1,60 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/mtt-labs/poly-market-sdk/auth"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ErrFunderMismatch is returned by NewClient when Config.Funder is not the maker derived from the signer
+// and SignatureType, set Config.SkipFunderCheck to use it anyway
+var ErrFunderMismatch = errors.New("funder does not match the signer")
+
+// resolveFunder returns the funder (order maker) of a signer for a signature type
+// An empty funder is derived from the signer address: the EOA itself, its proxy wallet or its Gnosis Safe.
+// A supplied funder must match the derived address, so that orders are not rejected for a wrong maker.
+func resolveFunder(network *Network, signatureType SignatureType, address, funder string) (string, error) {
+	if funder != "" && !common.IsHexAddress(funder) {
+		return "", fmt.Errorf("invalid funder address: %s", funder)
+	}
+	owner := common.HexToAddress(address)
+
+	expected, known, err := auth.ExpectedMaker(network.WalletFactories, int(signatureType), owner)
+	if err != nil {
+		return "", err
+	}
+	if !known {
+		if funder == "" {
+			return "", fmt.Errorf("funder is required for signature type %d on network %s", signatureType, network.Name)
+		}
+		return common.HexToAddress(funder).Hex(), nil
+	}
+
+	if funder == "" {
+		return expected.Hex(), nil
+	}
+	if common.HexToAddress(funder) != expected {
+		return "", fmt.Errorf("%w: funder %s does not match signature type %d of signer %s (expected %s)%s",
+			ErrFunderMismatch, funder, signatureType, address, expected.Hex(), funderHint(network, owner, common.HexToAddress(funder)))
+	}
+	return expected.Hex(), nil
+}
+
+// funderHint suggests the signature type matching a funder derived from the signer
+func funderHint(network *Network, owner, funder common.Address) string {
+	signatureType, ok := auth.MakerSignatureType(network.WalletFactories, owner, funder)
+	if !ok {
+		return ""
+	}
+	switch SignatureType(signatureType) {
+	case SignatureTypeEOA:
+		return ", use SignatureTypeEOA for the signer address"
+	case SignatureTypeEmailMagic:
+		return ", the funder is the proxy wallet of the signer, use SignatureTypeEmailMagic"
+	default:
+		return ", the funder is the Gnosis Safe of the signer, use SignatureTypeBrowserWallet"
+	}
+}
@@ -0,0 +1,88 @@
+package client
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/mtt-labs/poly-market-sdk/auth"
+)
+
+func TestResolveFunder(t *testing.T) {
+	proxy, _ := auth.ProxyWalletAddress(auth.PolygonChainID, testAddress)
+	safe, _ := auth.SafeAddress(auth.PolygonChainID, testAddress)
+
+	tests := []struct {
+		name          string
+		network       *Network
+		signatureType SignatureType
+		funder        string
+		want          string
+		wantErr       string
+	}{
+		{"EOA derived", MainnetNetwork(), SignatureTypeEOA, "", testAddress, ""},
+		{"proxy derived", MainnetNetwork(), SignatureTypeEmailMagic, "", proxy, ""},
+		{"Safe derived", MainnetNetwork(), SignatureTypeBrowserWallet, "", safe, ""},
+		{"proxy supplied in lower case", MainnetNetwork(), SignatureTypeEmailMagic, strings.ToLower(proxy), proxy, ""},
+		{"Safe given for the proxy type", MainnetNetwork(), SignatureTypeEmailMagic, safe, "", "use SignatureTypeBrowserWallet"},
+		{"proxy given for the Safe type", MainnetNetwork(), SignatureTypeBrowserWallet, proxy, "", "use SignatureTypeEmailMagic"},
+		{"signer given for the proxy type", MainnetNetwork(), SignatureTypeEmailMagic, testAddress, "", "use SignatureTypeEOA"},
+		{"Amoy requires a funder", AmoyNetwork(), SignatureTypeEmailMagic, "", "", "funder is required"},
+		{"Amoy accepts the funder", AmoyNetwork(), SignatureTypeBrowserWallet, safe, safe, ""},
+		{"unsupported signature type", MainnetNetwork(), SignatureType(3), "", "", "unsupported signature type"},
+		{"invalid funder", MainnetNetwork(), SignatureTypeEOA, "0x123", "", "invalid funder address"},
+	}
+	for _, tt := range tests {
+		got, err := resolveFunder(tt.network, tt.signatureType, testAddress, tt.funder)
+		if tt.wantErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("%s: error = %v, want %q", tt.name, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("%s: funder = %s, %v, want %s", tt.name, got, err, tt.want)
+		}
+	}
+}
+
+func TestNewClientFunderCheck(t *testing.T) {
+	safe, _ := auth.SafeAddress(auth.PolygonChainID, testAddress)
+	config := func() *Config {
+		return &Config{
+			PrivateKey:    testPrivateKey,
+			SignatureType: SignatureTypeEmailMagic,
+			Funder:        safe,
+			TimeSync:      &TimeSyncConfig{Disabled: true},
+		}
+	}
+
+	if _, err := NewClient(config()); !errors.Is(err, ErrFunderMismatch) {
+		t.Errorf("NewClient error = %v, want ErrFunderMismatch", err)
+	}
+
+	var logs bytes.Buffer
+	skipped := config()
+	skipped.SkipFunderCheck = true
+	skipped.Logger = slog.New(slog.NewTextHandler(&logs, nil))
+	c, err := NewClient(skipped)
+	if err != nil {
+		t.Fatalf("NewClient with SkipFunderCheck: %v", err)
+	}
+	if c.GetFunder() != safe {
+		t.Errorf("funder = %s, want %s", c.GetFunder(), safe)
+	}
+	if !strings.Contains(logs.String(), "level=WARN") || !strings.Contains(logs.String(), "use SignatureTypeBrowserWallet") {
+		t.Errorf("mismatch not logged as a warning:\n%s", logs.String())
+	}
+
+	// Other funder errors are not skipped
+	invalid := config()
+	invalid.SkipFunderCheck = true
+	invalid.Funder = "0x123"
+	if _, err := NewClient(invalid); err == nil {
+		t.Error("invalid funder expected an error")
+	}
+}
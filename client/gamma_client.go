@@ -1,8 +1,7 @@
 package client
 
 import (
-	"fmt"
-	"io"
+	"context"
 	"net/http"
 	"time"
 )
@@ -10,44 +9,42 @@ import (
 // GammaClient is a simple HTTP client for Gamma API (read-only, no authentication required)
 // Reference: https://docs.polymarket.com/developers/gamma-markets-api/overview
 type GammaClient struct {
-	baseURL    string
-	httpClient *http.Client
+	pipeline *pipeline
 }
 
-// NewGammaClient creates a new Gamma API client
+// DefaultGammaBaseURL default Gamma API address
+const DefaultGammaBaseURL = "https://gamma-api.polymarket.com"
+
+// NewGammaClient creates a new standalone Gamma API client with default settings
+// Prefer Client.GammaClient(), which honours client.Config (base URL, HTTP client, retries, rate limits)
 func NewGammaClient() *GammaClient {
-	return &GammaClient{
-		baseURL: "https://gamma-api.polymarket.com",
-		httpClient: &http.Client{
-			Timeout: 30 * time.Second,
-		},
+	httpClient := &http.Client{
+		Timeout: 30 * time.Second,
+	}
+	defaults := pipeline{
+		handler:   chainInterceptors(httpClient, nil),
+		logger:    discardLogger(),
+		telemetry: newTelemetry(nil, nil),
+		retry:     DefaultRetryPolicy(),
+		limiter:   NewRateLimiter(nil),
 	}
+	return &GammaClient{pipeline: defaults.forHost(APIGamma, DefaultGammaBaseURL, gammaEndpointGroup)}
+}
+
+// BaseURL gets the Gamma API base URL
+func (c *GammaClient) BaseURL() string {
+	return c.pipeline.baseURL
 }
 
 // Get executes GET request to Gamma API
 func (c *GammaClient) Get(endpoint string) ([]byte, error) {
-	url := c.baseURL + endpoint
-	req, err := http.NewRequest(http.MethodGet, url, nil)
-	if err != nil {
-		return nil, fmt.Errorf("create request: %w", err)
-	}
-
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("execute request: %w", err)
-	}
-	defer resp.Body.Close()
-
-	respBody, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("read response body: %w", err)
-	}
-
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("API error: status %d, body: %s", resp.StatusCode, string(respBody))
-	}
+	return c.GetCtx(context.Background(), endpoint)
+}
 
-	return respBody, nil
+// GetCtx executes GET request to Gamma API bound to ctx
+func (c *GammaClient) GetCtx(ctx context.Context, endpoint string) ([]byte, error) {
+	return c.pipeline.do(ctx, &request{
+		method:   http.MethodGet,
+		endpoint: endpoint,
+	})
 }
@@ -0,0 +1,69 @@
+package client
+
+import (
+	"net/http"
+	"time"
+)
+
+// API identifies the Polymarket API a request is sent to
+type API string
+
+const (
+	// APICLOB Central Limit Order Book API
+	APICLOB API = "clob"
+	// APIGamma Gamma markets API
+	APIGamma API = "gamma"
+	// APIData Data API
+	APIData API = "data"
+)
+
+// RequestInfo describes the API call an HTTP request belongs to
+type RequestInfo struct {
+	API       API           // Target API
+	Method    string        // HTTP method
+	Endpoint  string        // Endpoint including the query string (without base URL)
+	AuthLevel AuthLevel     // Authentication level (none, L1, L2)
+	Group     EndpointGroup // Rate limit group
+	Attempt   int           // Attempt number, starting at 1
+	Start     time.Time     // Start time of the attempt
+}
+
+// Elapsed returns the time since the attempt started
+func (i *RequestInfo) Elapsed() time.Duration {
+	return time.Since(i.Start)
+}
+
+// Handler sends an HTTP request and returns its response
+type Handler func(req *http.Request, info *RequestInfo) (*http.Response, error)
+
+// Interceptor wraps a Handler to inject behaviour around every request
+// (logging, metrics, header injection, request mutation, fault injection, ...)
+//
+// Interceptors run once per attempt, after rate limiting and signing, for CLOB, Gamma and
+// Data API requests alike. The first interceptor in Config.Interceptors is the outermost one.
+type Interceptor func(next Handler) Handler
+
+// HeaderInterceptor returns an interceptor that adds the given headers to every request
+func HeaderInterceptor(headers http.Header) Interceptor {
+	return func(next Handler) Handler {
+		return func(req *http.Request, info *RequestInfo) (*http.Response, error) {
+			for key, values := range headers {
+				for _, value := range values {
+					req.Header.Add(key, value)
+				}
+			}
+			return next(req, info)
+		}
+	}
+}
+
+// chainInterceptors builds the handler that sends requests through the interceptors
+func chainInterceptors(httpClient *http.Client, interceptors []Interceptor) Handler {
+	handler := Handler(func(req *http.Request, _ *RequestInfo) (*http.Response, error) {
+		return httpClient.Do(req)
+	})
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		handler = interceptors[i](handler)
+	}
+	return handler
+}
@@ -0,0 +1,182 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+// traceInterceptor records when it runs before and after the rest of the chain
+func traceInterceptor(name string, trace *[]string, mu *sync.Mutex) Interceptor {
+	return func(next Handler) Handler {
+		return func(req *http.Request, info *RequestInfo) (*http.Response, error) {
+			mu.Lock()
+			*trace = append(*trace, name+" before")
+			mu.Unlock()
+			resp, err := next(req, info)
+			mu.Lock()
+			*trace = append(*trace, name+" after")
+			mu.Unlock()
+			return resp, err
+		}
+	}
+}
+
+func TestInterceptorOrder(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		trace []string
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		trace = append(trace, "server")
+		mu.Unlock()
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL, func(config *Config) {
+		config.Interceptors = []Interceptor{traceInterceptor("outer", &trace, &mu), traceInterceptor("inner", &trace, &mu)}
+	})
+	if _, err := c.GetCtx(context.Background(), "/book"); err != nil {
+		t.Fatalf("GetCtx: %v", err)
+	}
+
+	want := []string{"outer before", "inner before", "server", "inner after", "outer after"}
+	if strings.Join(trace, ", ") != strings.Join(want, ", ") {
+		t.Errorf("trace = %v, want %v", trace, want)
+	}
+}
+
+func TestInterceptorRunsPerAttempt(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	var infos []RequestInfo
+	record := func(next Handler) Handler {
+		return func(req *http.Request, info *RequestInfo) (*http.Response, error) {
+			infos = append(infos, *info)
+			return next(req, info)
+		}
+	}
+	c := newTestClient(t, server.URL, withRetryPolicy(fastRetryPolicy()), func(config *Config) {
+		config.Interceptors = []Interceptor{record}
+	})
+	if _, err := c.GetCtx(context.Background(), "/book?token_id=1"); err != nil {
+		t.Fatalf("GetCtx: %v", err)
+	}
+
+	if len(infos) != 2 {
+		t.Fatalf("interceptor ran %d times, want once per attempt", len(infos))
+	}
+	for i, info := range infos {
+		if info.Attempt != i+1 || info.API != APICLOB || info.Method != http.MethodGet ||
+			info.Endpoint != "/book?token_id=1" || info.AuthLevel != AuthLevelNone || info.Group != EndpointGroupBookReads {
+			t.Errorf("attempt %d: info = %+v", i+1, info)
+		}
+	}
+}
+
+func TestInterceptorShortCircuit(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+	}))
+	defer server.Close()
+
+	// A canned response, the request never reaches the server
+	canned := func(next Handler) Handler {
+		return func(req *http.Request, info *RequestInfo) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{},
+				Body:       io.NopCloser(strings.NewReader(`{"cached":true}`)),
+				Request:    req,
+			}, nil
+		}
+	}
+	c := newTestClient(t, server.URL, func(config *Config) {
+		config.Interceptors = []Interceptor{canned}
+	})
+	data, err := c.GetCtx(context.Background(), "/book")
+	if err != nil || string(data) != `{"cached":true}` {
+		t.Errorf("GetCtx = %s, %v, want the canned response", data, err)
+	}
+
+	// An injected fault fails the request like a network error
+	errInjected := errors.New("injected fault")
+	fault := func(next Handler) Handler {
+		return func(req *http.Request, info *RequestInfo) (*http.Response, error) {
+			return nil, errInjected
+		}
+	}
+	c = newTestClient(t, server.URL, func(config *Config) {
+		config.Interceptors = []Interceptor{fault}
+	})
+	if _, err := c.GetCtx(context.Background(), "/book"); !errors.Is(err, errInjected) {
+		t.Errorf("GetCtx error = %v, want the injected fault", err)
+	}
+
+	if n := requests.Load(); n != 0 {
+		t.Errorf("server received %d requests, want 0", n)
+	}
+}
+
+func TestInterceptorHeaders(t *testing.T) {
+	var header http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Clone()
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	// Interceptors run after signing: they see the L2 headers and may add or replace headers
+	var signed bool
+	mutate := func(next Handler) Handler {
+		return func(req *http.Request, info *RequestInfo) (*http.Response, error) {
+			signed = req.Header.Get("POLY_SIGNATURE") != "" && info.AuthLevel == AuthLevelL2
+			req.Header.Set("User-Agent", "poly-market-sdk-test")
+			return next(req, info)
+		}
+	}
+	creds := credentialSet(1)
+	c := newTestClient(t, server.URL, func(config *Config) {
+		config.APIKey, config.APISecret, config.APIPassphrase = creds.Key, creds.Secret, creds.Passphrase
+		config.Interceptors = []Interceptor{
+			HeaderInterceptor(http.Header{"X-Request-Source": {"bot"}, "X-Tag": {"a", "b"}}),
+			mutate,
+		}
+	})
+	if _, err := c.DoWithL2Ctx(context.Background(), http.MethodGet, "/data/orders", nil); err != nil {
+		t.Fatalf("DoWithL2Ctx: %v", err)
+	}
+
+	if !signed {
+		t.Error("interceptor ran before the request was signed")
+	}
+	if got := header.Get("X-Request-Source"); got != "bot" {
+		t.Errorf("X-Request-Source = %q, want bot", got)
+	}
+	if got := header.Values("X-Tag"); strings.Join(got, ",") != "a,b" {
+		t.Errorf("X-Tag = %v, want [a b]", got)
+	}
+	if got := header.Get("User-Agent"); got != "poly-market-sdk-test" {
+		t.Errorf("User-Agent = %q, want the value set by the interceptor", got)
+	}
+	if header.Get("POLY_API_KEY") != creds.Key {
+		t.Errorf("POLY_API_KEY = %q, want %s", header.Get("POLY_API_KEY"), creds.Key)
+	}
+}
@@ -0,0 +1,58 @@
+package client
+
+import (
+	"log/slog"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// Redacted placeholder logged instead of secret values
+const Redacted = "[REDACTED]"
+
+// sensitiveHeaders headers whose values are never logged
+var sensitiveHeaders = map[string]bool{
+	"Poly_signature":  true,
+	"Poly_passphrase": true,
+	"Authorization":   true,
+	"Cookie":          true,
+	"Set-Cookie":      true,
+}
+
+// discardLogger logger used when Config.Logger is nil
+func discardLogger() *slog.Logger {
+	return slog.New(slog.DiscardHandler)
+}
+
+// headersAttr returns the headers as a log attribute with secrets redacted
+func headersAttr(key string, header http.Header) slog.Attr {
+	names := make([]string, 0, len(header))
+	for name := range header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	attrs := make([]slog.Attr, 0, len(names))
+	for _, name := range names {
+		value := strings.Join(header.Values(name), ", ")
+		switch {
+		case sensitiveHeaders[http.CanonicalHeaderKey(name)]:
+			value = Redacted
+		case http.CanonicalHeaderKey(name) == "Poly_api_key":
+			value = MaskSecret(value)
+		}
+		attrs = append(attrs, slog.String(name, value))
+	}
+	return slog.Attr{Key: key, Value: slog.GroupValue(attrs...)}
+}
+
+// MaskSecret masks an identifier for logging, keeping only its first 4 characters
+func MaskSecret(s string) string {
+	if s == "" {
+		return ""
+	}
+	if len(s) <= 8 {
+		return Redacted
+	}
+	return s[:4] + "..." + Redacted
+}
@@ -0,0 +1,95 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestRequestLogsRedactSecrets(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	var logs bytes.Buffer
+	creds := Credentials{
+		Key:        "5d1c4f2e-8b7a-4c3d-9e6f-0a1b2c3d4e5f",
+		Secret:     "c2VjcmV0LW9mLXRoZS1hcGkta2V5LXRoYXQtbXVzdC1uZXZlci1sZWFr",
+		Passphrase: "passphrase-that-must-never-leak",
+	}
+	c := newTestClient(t, server.URL, func(config *Config) {
+		config.Logger = slog.New(slog.NewJSONHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug}))
+		config.APIKey, config.APISecret, config.APIPassphrase = creds.Key, creds.Secret, creds.Passphrase
+	})
+
+	// L1 request
+	l1Headers, err := c.GetSigner().SignL1Auth(c.GetChainID(), c.GetAddress(), 1700000000, big.NewInt(0))
+	if err != nil {
+		t.Fatalf("SignL1Auth: %v", err)
+	}
+	if _, err := c.GetWithL1Ctx(context.Background(), "/auth/derive-api-key", l1Headers); err != nil {
+		t.Fatalf("GetWithL1Ctx: %v", err)
+	}
+
+	// L2 request, the signature is recomputed to know what must not be logged
+	l2Headers, err := c.SignL2Headers(http.MethodPost, "/order", `{"order":1}`)
+	if err != nil {
+		t.Fatalf("SignL2Headers: %v", err)
+	}
+	if _, err := c.PostWithL2Ctx(context.Background(), "/order", map[string]int{"order": 1}, l2Headers); err != nil {
+		t.Fatalf("PostWithL2Ctx: %v", err)
+	}
+
+	// The client itself
+	c.Logger().Info("client", slog.Any("client", c))
+	c.Logger().Info("client " + fmt.Sprintf("%v %+v %#v", c, c, c))
+
+	output := logs.String()
+	if !strings.Contains(output, "Poly_signature") || !strings.Contains(output, "/order") {
+		t.Fatalf("requests were not logged:\n%s", output)
+	}
+	secrets := map[string]string{
+		"L1 signature": l1Headers.Signature,
+		"L2 signature": l2Headers.Signature,
+		"secret":       creds.Secret,
+		"passphrase":   creds.Passphrase,
+		"API key":      creds.Key,
+		"private key":  testPrivateKey,
+	}
+	for name, secret := range secrets {
+		if strings.Contains(output, secret) {
+			t.Errorf("logs contain the %s", name)
+		}
+	}
+	// Only the masked API key is logged
+	if masked := MaskSecret(creds.Key); !strings.Contains(output, masked) {
+		t.Errorf("logs do not contain the masked API key %q", masked)
+	}
+	if n := strings.Count(output, Redacted); n < 4 {
+		t.Errorf("logs contain %d redacted values, want the signatures and passphrase redacted", n)
+	}
+}
+
+func TestMaskSecret(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{"", ""},
+		{"short", Redacted},
+		{"12345678", Redacted},
+		{"123456789", "1234..." + Redacted},
+	}
+	for _, tt := range tests {
+		if got := MaskSecret(tt.value); got != tt.want {
+			t.Errorf("MaskSecret(%q) = %q, want %q", tt.value, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package client
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mtt-labs/poly-market-sdk/auth"
+	orderconfig "github.com/polymarket/go-order-utils/pkg/config"
+)
+
+// Network profile of a Polymarket deployment: API hosts, chain and contracts
+// Selecting a network keeps authentication, order signing and on-chain helpers on the same chain
+// Empty hosts and contracts of a custom network are those of the preset of its chain ID
+type Network struct {
+	Name         string                 // Network name ("mainnet", "amoy")
+	ChainID      int                    // Chain ID used in EIP-712 domains
+	CLOBBaseURL  string                 // CLOB API base URL
+	GammaBaseURL string                 // Gamma API base URL
+	DataBaseURL  string                 // Data API base URL
+	RPCURL       string                 // Public JSON-RPC endpoint of the chain, for on-chain helpers (nonces)
+	Contracts    *orderconfig.Contracts // Exchange, neg-risk exchange, collateral and conditional tokens contracts
+	// Proxy wallet and Gnosis Safe factories used to derive the funder, nil when unknown (Funder must be set)
+	WalletFactories *auth.WalletFactories
+}
+
+// MainnetNetwork returns the Polygon mainnet profile
+func MainnetNetwork() *Network {
+	return &Network{
+		Name:         "mainnet",
+		ChainID:      auth.PolygonChainID,
+		CLOBBaseURL:  DefaultBaseURL,
+		GammaBaseURL: DefaultGammaBaseURL,
+		DataBaseURL:  DefaultDataBaseURL,
+		RPCURL:       "https://polygon-rpc.com",
+		Contracts:    mustContracts(auth.PolygonChainID),
+
+		WalletFactories: auth.PolygonWalletFactories(),
+	}
+}
+
+// AmoyNetwork returns the Polygon Amoy testnet profile
+// Markets and positions of the staging deployment are unrelated to mainnet
+func AmoyNetwork() *Network {
+	return &Network{
+		Name:         "amoy",
+		ChainID:      auth.AmoyChainID,
+		CLOBBaseURL:  "https://clob-staging.polymarket.com",
+		GammaBaseURL: "https://gamma-api-staging.polymarket.com",
+		DataBaseURL:  "https://data-api-staging.polymarket.com",
+		RPCURL:       "https://rpc-amoy.polygon.technology",
+		Contracts:    mustContracts(auth.AmoyChainID),
+	}
+}
+
+// NetworkForChain returns the network profile of a chain ID
+func NetworkForChain(chainID int) (*Network, error) {
+	switch chainID {
+	case auth.PolygonChainID:
+		return MainnetNetwork(), nil
+	case auth.AmoyChainID:
+		return AmoyNetwork(), nil
+	}
+	return nil, fmt.Errorf("unsupported chain ID: %d", chainID)
+}
+
+// mustContracts returns the contracts of a chain supported by go-order-utils
+func mustContracts(chainID int) *orderconfig.Contracts {
+	contracts, err := orderconfig.GetContracts(int64(chainID))
+	if err != nil {
+		panic(fmt.Sprintf("contracts of chain %d: %v", chainID, err))
+	}
+	copied := *contracts
+	return &copied
+}
+
+// resolveNetwork selects the network from config.Network, or from config.ChainID (default mainnet)
+func resolveNetwork(config *Config) (*Network, error) {
+	if config.Network == nil {
+		chainID := config.ChainID
+		if chainID == 0 {
+			chainID = auth.PolygonChainID
+		}
+		return NetworkForChain(chainID)
+	}
+
+	network := *config.Network
+	if config.ChainID != 0 && config.ChainID != network.ChainID {
+		return nil, fmt.Errorf("chain ID %d does not match network %s (chain ID %d)", config.ChainID, network.Name, network.ChainID)
+	}
+	if network.ChainID == 0 {
+		return nil, fmt.Errorf("network %s has no chain ID", network.Name)
+	}
+	if network.Contracts == nil {
+		contracts, err := orderconfig.GetContracts(int64(network.ChainID))
+		if err != nil {
+			return nil, fmt.Errorf("network %s has no contracts: %w", network.Name, err)
+		}
+		copied := *contracts
+		network.Contracts = &copied
+	}
+	if network.WalletFactories == nil {
+		network.WalletFactories, _ = auth.WalletFactoriesForChain(network.ChainID)
+	}
+
+	// Hosts left empty are those of the chain's preset, never the production APIs of another chain
+	if preset, err := NetworkForChain(network.ChainID); err == nil {
+		if network.CLOBBaseURL == "" {
+			network.CLOBBaseURL = preset.CLOBBaseURL
+		}
+		if network.GammaBaseURL == "" {
+			network.GammaBaseURL = preset.GammaBaseURL
+		}
+		if network.DataBaseURL == "" {
+			network.DataBaseURL = preset.DataBaseURL
+		}
+		if network.RPCURL == "" {
+			network.RPCURL = preset.RPCURL
+		}
+	}
+
+	// Without a preset, every host must be set by the network or the config
+	var missing []string
+	if network.CLOBBaseURL == "" && config.BaseURL == "" {
+		missing = append(missing, "CLOB")
+	}
+	if network.GammaBaseURL == "" && config.GammaBaseURL == "" {
+		missing = append(missing, "Gamma")
+	}
+	if network.DataBaseURL == "" && config.DataBaseURL == "" {
+		missing = append(missing, "Data")
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("network %s (chain ID %d) has no preset, %s API base URL required",
+			network.Name, network.ChainID, strings.Join(missing, ", "))
+	}
+	return &network, nil
+}
@@ -0,0 +1,139 @@
+package client
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/mtt-labs/poly-market-sdk/auth"
+
+	"github.com/ethereum/go-ethereum/common"
+	orderconfig "github.com/polymarket/go-order-utils/pkg/config"
+)
+
+func TestNetworkForChain(t *testing.T) {
+	tests := []struct {
+		chainID  int
+		name     string
+		clobURL  string
+		factory  bool
+		contract orderconfig.Contracts
+	}{
+		{
+			chainID: auth.PolygonChainID,
+			name:    "mainnet",
+			clobURL: "https://clob.polymarket.com",
+			factory: true,
+			contract: orderconfig.Contracts{
+				Exchange:        common.HexToAddress("0x4bFb41d5B3570DeFd03C39a9A4D8dE6Bd8B8982E"),
+				NegRiskExchange: common.HexToAddress("0xC5d563A36AE78145C45a50134d48A1215220f80a"),
+				Collateral:      common.HexToAddress("0x2791Bca1f2de4661ED88A30C99A7a9449Aa84174"),
+				Conditional:     common.HexToAddress("0x4D97DCd97eC945f40cF65F87097ACe5EA0476045"),
+			},
+		},
+		{
+			chainID: auth.AmoyChainID,
+			name:    "amoy",
+			clobURL: "https://clob-staging.polymarket.com",
+			contract: orderconfig.Contracts{
+				Exchange:        common.HexToAddress("0xdFE02Eb6733538f8Ea35D585af8DE5958AD99E40"),
+				NegRiskExchange: common.HexToAddress("0xC5d563A36AE78145C45a50134d48A1215220f80a"),
+				Collateral:      common.HexToAddress("0x9c4e1703476e875070ee25b56a58b008cfb8fa78"),
+				Conditional:     common.HexToAddress("0x69308FB512518e39F9b16112fA8d994F4e2Bf8bB"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		network, err := NetworkForChain(tt.chainID)
+		if err != nil {
+			t.Fatalf("NetworkForChain(%d): %v", tt.chainID, err)
+		}
+		if network.Name != tt.name || network.ChainID != tt.chainID || network.CLOBBaseURL != tt.clobURL {
+			t.Errorf("chain %d: network %s, chain ID %d, CLOB %s", tt.chainID, network.Name, network.ChainID, network.CLOBBaseURL)
+		}
+		if network.GammaBaseURL == "" || network.DataBaseURL == "" || network.RPCURL == "" {
+			t.Errorf("chain %d: missing hosts %+v", tt.chainID, network)
+		}
+		contracts := network.Contracts
+		if contracts.Exchange != tt.contract.Exchange || contracts.NegRiskExchange != tt.contract.NegRiskExchange ||
+			contracts.Collateral != tt.contract.Collateral || contracts.Conditional != tt.contract.Conditional {
+			t.Errorf("chain %d: contracts = %+v, want %+v", tt.chainID, contracts, tt.contract)
+		}
+		if (network.WalletFactories != nil) != tt.factory {
+			t.Errorf("chain %d: wallet factories = %v, want known %v", tt.chainID, network.WalletFactories, tt.factory)
+		}
+
+		// Presets are copies, changing one leaves the others unchanged
+		network.Contracts.Exchange = common.Address{}
+		if again, _ := NetworkForChain(tt.chainID); again.Contracts.Exchange != tt.contract.Exchange {
+			t.Errorf("chain %d: preset contracts were modified through a returned network", tt.chainID)
+		}
+	}
+
+	if _, err := NetworkForChain(1); err == nil {
+		t.Error("NetworkForChain(1) expected an error")
+	}
+}
+
+func TestResolveNetwork(t *testing.T) {
+	custom := &orderconfig.Contracts{Exchange: common.HexToAddress("0x00000000000000000000000000000000000000e1")}
+	tests := []struct {
+		name      string
+		config    *Config
+		wantName  string
+		wantChain int
+		wantCLOB  string
+		wantErr   string
+	}{
+		{"default mainnet", &Config{}, "mainnet", auth.PolygonChainID, DefaultBaseURL, ""},
+		{"chain ID", &Config{ChainID: auth.AmoyChainID}, "amoy", auth.AmoyChainID, "https://clob-staging.polymarket.com", ""},
+		{"network", &Config{Network: AmoyNetwork()}, "amoy", auth.AmoyChainID, "https://clob-staging.polymarket.com", ""},
+		{"network and matching chain ID", &Config{Network: AmoyNetwork(), ChainID: auth.AmoyChainID}, "amoy", auth.AmoyChainID, "https://clob-staging.polymarket.com", ""},
+		{"network and other chain ID", &Config{Network: AmoyNetwork(), ChainID: auth.PolygonChainID}, "", 0, "", "does not match network amoy"},
+		{"unsupported chain ID", &Config{ChainID: 1}, "", 0, "", "unsupported chain ID"},
+		{"network without chain ID", &Config{Network: &Network{Name: "custom"}}, "", 0, "", "has no chain ID"},
+		{
+			"Amoy network without hosts uses the Amoy hosts",
+			&Config{Network: &Network{Name: "custom", ChainID: auth.AmoyChainID}},
+			"custom", auth.AmoyChainID, "https://clob-staging.polymarket.com", "",
+		},
+		{
+			"unknown chain without hosts",
+			&Config{Network: &Network{Name: "local", ChainID: 1337, Contracts: custom}},
+			"", 0, "", "has no preset, CLOB, Gamma, Data API base URL required",
+		},
+		{
+			"unknown chain without contracts",
+			&Config{Network: &Network{Name: "local", ChainID: 1337, CLOBBaseURL: "http://localhost"}},
+			"", 0, "", "has no contracts",
+		},
+		{
+			"unknown chain with hosts from the config",
+			&Config{
+				Network:      &Network{Name: "local", ChainID: 1337, Contracts: custom, CLOBBaseURL: "http://localhost:8080"},
+				GammaBaseURL: "http://localhost:8081",
+				DataBaseURL:  "http://localhost:8082",
+			},
+			"local", 1337, "http://localhost:8080", "",
+		},
+	}
+	for _, tt := range tests {
+		network, err := resolveNetwork(tt.config)
+		if tt.wantErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("%s: error = %v, want %q", tt.name, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if network.Name != tt.wantName || network.ChainID != tt.wantChain || network.CLOBBaseURL != tt.wantCLOB {
+			t.Errorf("%s: network %s, chain ID %d, CLOB %s, want %s, %d, %s", tt.name,
+				network.Name, network.ChainID, network.CLOBBaseURL, tt.wantName, tt.wantChain, tt.wantCLOB)
+		}
+		if network.Contracts == nil {
+			t.Errorf("%s: no contracts", tt.name)
+		}
+	}
+}
@@ -0,0 +1,294 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptrace"
+	"time"
+
+	"github.com/mtt-labs/poly-market-sdk/auth"
+)
+
+// AuthLevel authentication level of a request
+// Reference: https://docs.polymarket.com/developers/CLOB/authentication
+type AuthLevel int
+
+const (
+	// AuthLevelNone public endpoint, no authentication headers
+	AuthLevelNone AuthLevel = 0
+	// AuthLevelL1 private key authentication (EIP-712 signature)
+	AuthLevelL1 AuthLevel = 1
+	// AuthLevelL2 API key authentication (HMAC signature)
+	AuthLevelL2 AuthLevel = 2
+)
+
+// String returns the name of the auth level
+func (l AuthLevel) String() string {
+	switch l {
+	case AuthLevelL1:
+		return "L1"
+	case AuthLevelL2:
+		return "L2"
+	default:
+		return "none"
+	}
+}
+
+// request a single API call executed by a pipeline
+type request struct {
+	method    string
+	endpoint  string
+	body      interface{}
+	l1Headers *auth.L1AuthHeaders
+	l2Headers *auth.L2AuthHeaders
+	// signL2 signs L2 headers for the serialized body, called again for every attempt
+	// because the timestamp is part of the HMAC; takes precedence over l2Headers
+	signL2 func(body string) (*auth.L2AuthHeaders, error)
+}
+
+// authLevel returns the authentication level of the request
+func (r *request) authLevel() AuthLevel {
+	switch {
+	case r.l2Headers != nil || r.signL2 != nil:
+		return AuthLevelL2
+	case r.l1Headers != nil:
+		return AuthLevelL1
+	default:
+		return AuthLevelNone
+	}
+}
+
+// pipeline executes requests against a single API host
+// It is shared by Client (CLOB API), GammaClient and DataClient so that every request
+// gets the same retry, rate limiting and interceptor behaviour
+type pipeline struct {
+	api           API
+	baseURL       string
+	handler       Handler // HTTP client wrapped by the configured interceptors
+	logger        *slog.Logger
+	telemetry     *Telemetry
+	retry         *RetryPolicy
+	limiter       *RateLimiter                                // Client-side rate limiter, nil disables it
+	endpointGroup func(method, endpoint string) EndpointGroup // Maps a request to its rate limit group
+}
+
+// forHost returns a copy of the pipeline targeting another API host
+func (p pipeline) forHost(api API, baseURL string, endpointGroup func(method, endpoint string) EndpointGroup) *pipeline {
+	p.api = api
+	p.baseURL = baseURL
+	p.endpointGroup = endpointGroup
+	return &p
+}
+
+// attemptError error of a single attempt, with the information needed to decide on a retry
+type attemptError struct {
+	err        error
+	permanent  bool          // The error must not be retried
+	connected  bool          // Whether a connection to the server was obtained
+	retryAfter time.Duration // Delay requested by the server via Retry-After
+}
+
+// do executes the request, retrying according to the retry policy
+// The whole call, retries included, is traced as a single span
+func (p *pipeline) do(ctx context.Context, r *request) ([]byte, error) {
+	start := time.Now()
+	ctx, span := p.telemetry.startCall(ctx, p.api, r.method, r.endpoint, r.authLevel())
+	respBody, attempts, statusCode, err := p.execute(ctx, r)
+	p.telemetry.endCall(ctx, span, p.api, r.method, r.endpoint, start, attempts, statusCode, err)
+	return respBody, err
+}
+
+// execute runs the attempts of a request, returning the number of attempts and the last status code
+func (p *pipeline) execute(ctx context.Context, r *request) ([]byte, int, int, error) {
+	var bodyBytes []byte
+	if r.body != nil {
+		jsonData, err := json.Marshal(r.body)
+		if err != nil {
+			return nil, 0, 0, fmt.Errorf("marshal request body: %w", err)
+		}
+		bodyBytes = jsonData
+	}
+
+	for attempt := 1; ; attempt++ {
+		respBody, statusCode, attemptErr := p.attempt(ctx, r, bodyBytes, attempt)
+		if attemptErr == nil {
+			return respBody, attempt, statusCode, nil
+		}
+
+		if !p.shouldRetry(ctx, r.method, attempt, attemptErr) {
+			return nil, attempt, statusCode, attemptErr.err
+		}
+
+		delay := p.retry.backoff(attempt, attemptErr.retryAfter)
+		p.logger.LogAttrs(ctx, slog.LevelDebug, "polymarket request retry",
+			slog.String("api", string(p.api)),
+			slog.String("method", r.method),
+			slog.String("endpoint", r.endpoint),
+			slog.Int("attempt", attempt),
+			slog.Duration("backoff", delay),
+			slog.String("error", attemptErr.err.Error()),
+		)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, attempt, statusCode, fmt.Errorf("%w (retry aborted: %v)", attemptErr.err, ctx.Err())
+		case <-timer.C:
+		}
+	}
+}
+
+// shouldRetry decides whether a failed attempt is retried
+func (p *pipeline) shouldRetry(ctx context.Context, method string, attempt int, attemptErr *attemptError) bool {
+	if attemptErr.permanent || p.retry == nil || attempt >= p.retry.MaxAttempts || ctx.Err() != nil {
+		return false
+	}
+
+	var apiErr *APIError
+	if errors.As(attemptErr.err, &apiErr) {
+		// The server processed the request, only repeat it if that is harmless
+		return isIdempotent(method) && p.retry.isRetryableStatus(apiErr.StatusCode)
+	}
+
+	if isIdempotent(method) {
+		return true
+	}
+
+	// Non-idempotent requests are only retried when nothing was ever sent to the server
+	return !attemptErr.connected
+}
+
+// attempt executes a single HTTP round trip
+func (p *pipeline) attempt(ctx context.Context, r *request, bodyBytes []byte, attempt int) ([]byte, int, *attemptError) {
+	var reqBody io.Reader
+	if bodyBytes != nil {
+		reqBody = bytes.NewReader(bodyBytes)
+	}
+
+	group := EndpointGroupDefault
+	if p.endpointGroup != nil {
+		group = p.endpointGroup(r.method, r.endpoint)
+	}
+
+	// Every attempt consumes a token, wait before signing so the timestamp is fresh
+	if p.limiter != nil {
+		if err := p.limiter.Wait(ctx, group); err != nil {
+			return nil, 0, &attemptError{err: fmt.Errorf("rate limit: %w", err), permanent: true}
+		}
+	}
+
+	// Headers are signed per attempt (the timestamp is part of the HMAC)
+	l2Headers := r.l2Headers
+	if r.signL2 != nil {
+		var err error
+		l2Headers, err = r.signL2(string(bodyBytes))
+		if err != nil {
+			return nil, 0, &attemptError{err: fmt.Errorf("sign L2 auth: %w", err), permanent: true}
+		}
+	}
+
+	attemptErr := &attemptError{}
+	trace := &httptrace.ClientTrace{
+		GotConn: func(httptrace.GotConnInfo) { attemptErr.connected = true },
+	}
+
+	url := p.baseURL + r.endpoint
+	req, err := http.NewRequestWithContext(httptrace.WithClientTrace(ctx, trace), r.method, url, reqBody)
+	if err != nil {
+		attemptErr.err = fmt.Errorf("create request: %w", err)
+		attemptErr.permanent = true
+		return nil, 0, attemptErr
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	// Add L1 Header (if needed)
+	// Reference: https://docs.polymarket.com/developers/CLOB/authentication
+	if r.l1Headers != nil {
+		req.Header.Set("POLY_ADDRESS", r.l1Headers.Address)
+		req.Header.Set("POLY_SIGNATURE", r.l1Headers.Signature)
+		req.Header.Set("POLY_TIMESTAMP", r.l1Headers.Timestamp)
+		req.Header.Set("POLY_NONCE", r.l1Headers.Nonce)
+	}
+
+	// Add L2 Header (if needed)
+	// Reference: https://docs.polymarket.com/developers/CLOB/authentication
+	if l2Headers != nil {
+		req.Header.Set("POLY_ADDRESS", l2Headers.Address)
+		req.Header.Set("POLY_SIGNATURE", l2Headers.Signature)
+		req.Header.Set("POLY_TIMESTAMP", l2Headers.Timestamp)
+		req.Header.Set("POLY_API_KEY", l2Headers.APIKey)
+		req.Header.Set("POLY_PASSPHRASE", l2Headers.Passphrase)
+	}
+
+	info := &RequestInfo{
+		API:       p.api,
+		Method:    r.method,
+		Endpoint:  r.endpoint,
+		AuthLevel: r.authLevel(),
+		Group:     group,
+		Attempt:   attempt,
+		Start:     time.Now(),
+	}
+
+	p.logger.LogAttrs(ctx, slog.LevelDebug, "polymarket request",
+		slog.String("api", string(info.API)),
+		slog.String("method", info.Method),
+		slog.String("endpoint", info.Endpoint),
+		slog.String("auth", info.AuthLevel.String()),
+		slog.Int("attempt", info.Attempt),
+		slog.Int("body_bytes", len(bodyBytes)),
+		headersAttr("headers", req.Header),
+	)
+
+	resp, err := p.handler(req, info)
+	if err != nil {
+		attemptErr.err = fmt.Errorf("execute request: %w", err)
+		p.logResponse(ctx, info, 0, 0, attemptErr.err)
+		return nil, 0, attemptErr
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		attemptErr.err = fmt.Errorf("read response body: %w", err)
+		p.logResponse(ctx, info, resp.StatusCode, 0, attemptErr.err)
+		return nil, resp.StatusCode, attemptErr
+	}
+
+	p.logResponse(ctx, info, resp.StatusCode, len(respBody), nil)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		attemptErr.err = NewAPIError(r.method, r.endpoint, resp.StatusCode, respBody)
+		if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable {
+			attemptErr.retryAfter = parseRetryAfter(resp.Header.Get("Retry-After"), time.Now())
+		}
+		return nil, resp.StatusCode, attemptErr
+	}
+
+	return respBody, resp.StatusCode, nil
+}
+
+// logResponse logs the outcome of an attempt at debug level
+func (p *pipeline) logResponse(ctx context.Context, info *RequestInfo, statusCode, size int, err error) {
+	attrs := []slog.Attr{
+		slog.String("api", string(info.API)),
+		slog.String("method", info.Method),
+		slog.String("endpoint", info.Endpoint),
+		slog.Int("attempt", info.Attempt),
+		slog.Int("status", statusCode),
+		slog.Int("response_bytes", size),
+		slog.Duration("duration", info.Elapsed()),
+	}
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+	}
+	p.logger.LogAttrs(ctx, slog.LevelDebug, "polymarket response", attrs...)
+}
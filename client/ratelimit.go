@@ -0,0 +1,269 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// EndpointGroup group of endpoints sharing a rate limit budget
+// Reference: https://docs.polymarket.com/quickstart/introduction/rate-limits
+type EndpointGroup string
+
+const (
+	// EndpointGroupOrderPlacement order placement (POST /order, POST /orders)
+	EndpointGroupOrderPlacement EndpointGroup = "order_placement"
+	// EndpointGroupCancels order cancellation (DELETE /order, /orders, /cancel-all, /cancel-market-orders)
+	EndpointGroupCancels EndpointGroup = "cancels"
+	// EndpointGroupBookReads public market data (/book, /price, /midpoint, /spread, /tick-size, ...)
+	EndpointGroupBookReads EndpointGroup = "book_reads"
+	// EndpointGroupGammaReads Gamma API reads (markets, events, search)
+	EndpointGroupGammaReads EndpointGroup = "gamma_reads"
+	// EndpointGroupDataReads Data API reads (positions, activity, holders)
+	EndpointGroupDataReads EndpointGroup = "data_reads"
+	// EndpointGroupDefault any other CLOB endpoint
+	EndpointGroupDefault EndpointGroup = "default"
+)
+
+// RateLimitPolicy behaviour when a budget is exhausted
+type RateLimitPolicy int
+
+const (
+	// RateLimitWait queues the request until a token is available (or ctx is done)
+	RateLimitWait RateLimitPolicy = 0
+	// RateLimitFailFast fails the request immediately with an error matching ErrRateLimited
+	RateLimitFailFast RateLimitPolicy = 1
+)
+
+// RateLimit token bucket budget
+type RateLimit struct {
+	Rate  float64 // Sustained requests per second, <= 0 disables limiting for the group
+	Burst int     // Maximum number of requests that can be sent at once
+}
+
+// RateLimitConfig client-side rate limiter configuration
+type RateLimitConfig struct {
+	Disabled bool                        // Disable client-side rate limiting entirely
+	Policy   RateLimitPolicy             // Behaviour when a budget is exhausted, default RateLimitWait
+	Limits   map[EndpointGroup]RateLimit // Per-group budgets, missing groups use DefaultRateLimits
+}
+
+// DefaultRateLimits returns budgets that stay below Polymarket's documented per-10s limits
+// Reference: https://docs.polymarket.com/quickstart/introduction/rate-limits
+func DefaultRateLimits() map[EndpointGroup]RateLimit {
+	return map[EndpointGroup]RateLimit{
+		EndpointGroupOrderPlacement: {Rate: 60, Burst: 350},
+		EndpointGroupCancels:        {Rate: 50, Burst: 300},
+		EndpointGroupBookReads:      {Rate: 100, Burst: 300},
+		EndpointGroupGammaReads:     {Rate: 25, Burst: 50},
+		EndpointGroupDataReads:      {Rate: 10, Burst: 50},
+		EndpointGroupDefault:        {Rate: 400, Burst: 1000},
+	}
+}
+
+// RateLimitBudget current state of a group's budget (for monitoring)
+type RateLimitBudget struct {
+	Group     EndpointGroup
+	Available float64 // Tokens currently available, negative when requests are queued
+	Rate      float64
+	Burst     int
+}
+
+// RateLimiter token bucket rate limiter with one bucket per endpoint group
+// It is safe for concurrent use
+type RateLimiter struct {
+	mu      sync.Mutex
+	policy  RateLimitPolicy
+	buckets map[EndpointGroup]*tokenBucket
+	now     func() time.Time
+}
+
+// tokenBucket a single token bucket
+type tokenBucket struct {
+	limit  RateLimit
+	tokens float64
+	last   time.Time
+}
+
+// NewRateLimiter creates a rate limiter from config, nil config uses DefaultRateLimits
+// Returns nil when rate limiting is disabled
+func NewRateLimiter(config *RateLimitConfig) *RateLimiter {
+	if config != nil && config.Disabled {
+		return nil
+	}
+
+	limits := DefaultRateLimits()
+	policy := RateLimitWait
+	if config != nil {
+		policy = config.Policy
+		for group, limit := range config.Limits {
+			limits[group] = limit
+		}
+	}
+
+	now := time.Now()
+	buckets := make(map[EndpointGroup]*tokenBucket, len(limits))
+	for group, limit := range limits {
+		if limit.Rate <= 0 {
+			continue
+		}
+		if limit.Burst < 1 {
+			limit.Burst = 1
+		}
+		buckets[group] = &tokenBucket{limit: limit, tokens: float64(limit.Burst), last: now}
+	}
+
+	return &RateLimiter{
+		policy:  policy,
+		buckets: buckets,
+		now:     time.Now,
+	}
+}
+
+// Wait takes a token from the group's budget, waiting or failing according to the policy
+func (l *RateLimiter) Wait(ctx context.Context, group EndpointGroup) error {
+	if l == nil {
+		return nil
+	}
+
+	l.mu.Lock()
+	b, ok := l.buckets[group]
+	if !ok {
+		l.mu.Unlock()
+		return nil
+	}
+	now := l.now()
+	b.refill(now)
+
+	if b.tokens < 1 && l.policy == RateLimitFailFast {
+		l.mu.Unlock()
+		return fmt.Errorf("%w: client-side budget for %s exhausted", ErrRateLimited, group)
+	}
+
+	// Reserve a token, going negative queues the request behind earlier reservations
+	b.tokens--
+	var delay time.Duration
+	if b.tokens < 0 {
+		delay = time.Duration(-b.tokens / b.limit.Rate * float64(time.Second))
+	}
+	l.mu.Unlock()
+
+	if delay == 0 {
+		return nil
+	}
+
+	if deadline, ok := ctx.Deadline(); ok && deadline.Before(now.Add(delay)) {
+		l.cancelReservation(group)
+		return fmt.Errorf("%w: client-side budget for %s exhausted before deadline", ErrRateLimited, group)
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		l.cancelReservation(group)
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// cancelReservation returns a reserved token to the group's budget
+func (l *RateLimiter) cancelReservation(group EndpointGroup) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if b, ok := l.buckets[group]; ok {
+		b.tokens++
+	}
+}
+
+// Budget returns the current budget of a group
+func (l *RateLimiter) Budget(group EndpointGroup) (RateLimitBudget, bool) {
+	if l == nil {
+		return RateLimitBudget{}, false
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	b, ok := l.buckets[group]
+	if !ok {
+		return RateLimitBudget{}, false
+	}
+	b.refill(l.now())
+	return RateLimitBudget{
+		Group:     group,
+		Available: b.tokens,
+		Rate:      b.limit.Rate,
+		Burst:     b.limit.Burst,
+	}, true
+}
+
+// Budgets returns the current budget of every limited group
+func (l *RateLimiter) Budgets() []RateLimitBudget {
+	if l == nil {
+		return nil
+	}
+
+	l.mu.Lock()
+	groups := make([]EndpointGroup, 0, len(l.buckets))
+	for group := range l.buckets {
+		groups = append(groups, group)
+	}
+	l.mu.Unlock()
+
+	budgets := make([]RateLimitBudget, 0, len(groups))
+	for _, group := range groups {
+		if budget, ok := l.Budget(group); ok {
+			budgets = append(budgets, budget)
+		}
+	}
+	return budgets
+}
+
+// refill adds the tokens accumulated since the last update
+func (b *tokenBucket) refill(now time.Time) {
+	elapsed := now.Sub(b.last).Seconds()
+	if elapsed <= 0 {
+		return
+	}
+	b.last = now
+	b.tokens += elapsed * b.limit.Rate
+	if b.tokens > float64(b.limit.Burst) {
+		b.tokens = float64(b.limit.Burst)
+	}
+}
+
+// clobEndpointGroup classifies a CLOB API request into its rate limit group
+func clobEndpointGroup(method, endpoint string) EndpointGroup {
+	path, _, _ := strings.Cut(endpoint, "?")
+
+	switch path {
+	case "/order", "/orders":
+		switch method {
+		case http.MethodPost:
+			return EndpointGroupOrderPlacement
+		case http.MethodDelete:
+			return EndpointGroupCancels
+		}
+	case "/cancel-all", "/cancel-market-orders":
+		return EndpointGroupCancels
+	case "/book", "/books", "/price", "/prices", "/midpoint", "/midpoints", "/spread", "/spreads",
+		"/last-trade-price", "/last-trades-prices", "/tick-size", "/neg-risk", "/fee-rate":
+		return EndpointGroupBookReads
+	}
+
+	return EndpointGroupDefault
+}
+
+// gammaEndpointGroup classifies a Gamma API request into its rate limit group
+func gammaEndpointGroup(method, endpoint string) EndpointGroup {
+	return EndpointGroupGammaReads
+}
+
+// dataEndpointGroup classifies a Data API request into its rate limit group
+func dataEndpointGroup(method, endpoint string) EndpointGroup {
+	return EndpointGroupDataReads
+}
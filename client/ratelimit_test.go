@@ -0,0 +1,242 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeClock clock of a rate limiter, only moving when advanced
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+// newFakeClockLimiter creates a rate limiter whose buckets refill on a fake clock
+// The clock starts at the current time, so that context deadlines stay comparable
+func newFakeClockLimiter(policy RateLimitPolicy, limit RateLimit) (*RateLimiter, *fakeClock) {
+	limiter := NewRateLimiter(&RateLimitConfig{
+		Policy: policy,
+		Limits: map[EndpointGroup]RateLimit{EndpointGroupBookReads: limit},
+	})
+	clock := &fakeClock{now: time.Now()}
+	limiter.now = clock.Now
+	for _, b := range limiter.buckets {
+		b.last = clock.now
+	}
+	return limiter, clock
+}
+
+func expectAvailable(t *testing.T, limiter *RateLimiter, want float64) {
+	t.Helper()
+	budget, ok := limiter.Budget(EndpointGroupBookReads)
+	if !ok || budget.Available != want {
+		t.Errorf("available tokens = %v, want %v", budget.Available, want)
+	}
+}
+
+func TestRateLimiterRefill(t *testing.T) {
+	limiter, clock := newFakeClockLimiter(RateLimitFailFast, RateLimit{Rate: 2, Burst: 5})
+	ctx := context.Background()
+
+	for i := range 5 {
+		if err := limiter.Wait(ctx, EndpointGroupBookReads); err != nil {
+			t.Fatalf("request %d within the burst: %v", i+1, err)
+		}
+	}
+	if err := limiter.Wait(ctx, EndpointGroupBookReads); !errors.Is(err, ErrRateLimited) {
+		t.Fatalf("request beyond the burst: error = %v, want ErrRateLimited", err)
+	}
+
+	// 2 tokens per second
+	clock.Advance(500 * time.Millisecond)
+	expectAvailable(t, limiter, 1)
+	if err := limiter.Wait(ctx, EndpointGroupBookReads); err != nil {
+		t.Errorf("request after refill: %v", err)
+	}
+	if err := limiter.Wait(ctx, EndpointGroupBookReads); !errors.Is(err, ErrRateLimited) {
+		t.Errorf("request after the refilled token: error = %v, want ErrRateLimited", err)
+	}
+
+	// Refill is capped at the burst
+	clock.Advance(time.Hour)
+	expectAvailable(t, limiter, 5)
+
+	// Groups without budget are not limited
+	for range 10 {
+		if err := limiter.Wait(ctx, EndpointGroup("unlimited")); err != nil {
+			t.Fatalf("unlimited group: %v", err)
+		}
+	}
+}
+
+func TestRateLimiterWaitPolicy(t *testing.T) {
+	// A token every 20ms, the clock is frozen so only the reservation delay counts
+	limiter, _ := newFakeClockLimiter(RateLimitWait, RateLimit{Rate: 50, Burst: 1})
+	ctx := context.Background()
+
+	if err := limiter.Wait(ctx, EndpointGroupBookReads); err != nil {
+		t.Fatalf("first request: %v", err)
+	}
+	start := time.Now()
+	if err := limiter.Wait(ctx, EndpointGroupBookReads); err != nil {
+		t.Fatalf("queued request: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 15*time.Millisecond {
+		t.Errorf("queued request waited %v, want about 20ms", elapsed)
+	}
+	expectAvailable(t, limiter, -1)
+}
+
+func TestRateLimiterCancelsReservations(t *testing.T) {
+	// A token per second, a queued request waits about a second
+	limiter, _ := newFakeClockLimiter(RateLimitWait, RateLimit{Rate: 1, Burst: 1})
+	if err := limiter.Wait(context.Background(), EndpointGroupBookReads); err != nil {
+		t.Fatalf("first request: %v", err)
+	}
+	expectAvailable(t, limiter, 0)
+
+	// The deadline expires before the token is available: fail at once and give the reservation back
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if err := limiter.Wait(ctx, EndpointGroupBookReads); !errors.Is(err, ErrRateLimited) {
+		t.Errorf("request past its deadline: error = %v, want ErrRateLimited", err)
+	}
+	if elapsed := time.Since(start); elapsed > 50*time.Millisecond {
+		t.Errorf("request past its deadline waited %v", elapsed)
+	}
+	expectAvailable(t, limiter, 0)
+
+	// The context is cancelled while queued
+	ctx, cancel = context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- limiter.Wait(ctx, EndpointGroupBookReads) }()
+	for deadline := time.Now().Add(time.Second); ; time.Sleep(time.Millisecond) {
+		if budget, _ := limiter.Budget(EndpointGroupBookReads); budget.Available < 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("request not queued")
+		}
+	}
+	cancel()
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("cancelled request: error = %v, want context.Canceled", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("cancelled request still waiting")
+	}
+	expectAvailable(t, limiter, 0)
+}
+
+func TestNewRateLimiter(t *testing.T) {
+	if NewRateLimiter(&RateLimitConfig{Disabled: true}) != nil {
+		t.Error("disabled rate limiter is not nil")
+	}
+	var disabled *RateLimiter
+	if err := disabled.Wait(context.Background(), EndpointGroupBookReads); err != nil || disabled.Budgets() != nil {
+		t.Error("nil rate limiter limits requests")
+	}
+
+	limiter := NewRateLimiter(&RateLimitConfig{Limits: map[EndpointGroup]RateLimit{
+		EndpointGroupDataReads:  {Rate: 0},
+		EndpointGroupGammaReads: {Rate: 5, Burst: 0},
+	}})
+	if _, ok := limiter.Budget(EndpointGroupDataReads); ok {
+		t.Error("group with a zero rate is limited")
+	}
+	if budget, _ := limiter.Budget(EndpointGroupGammaReads); budget.Burst != 1 || budget.Rate != 5 {
+		t.Errorf("gamma budget = %+v, want rate 5 and burst 1", budget)
+	}
+	if budget, _ := limiter.Budget(EndpointGroupOrderPlacement); budget.Burst != DefaultRateLimits()[EndpointGroupOrderPlacement].Burst {
+		t.Errorf("order placement budget = %+v, want the default", budget)
+	}
+}
+
+func TestClobEndpointGroup(t *testing.T) {
+	tests := []struct {
+		method   string
+		endpoint string
+		want     EndpointGroup
+	}{
+		{http.MethodPost, "/order", EndpointGroupOrderPlacement},
+		{http.MethodPost, "/orders", EndpointGroupOrderPlacement},
+		{http.MethodDelete, "/order", EndpointGroupCancels},
+		{http.MethodDelete, "/orders", EndpointGroupCancels},
+		{http.MethodDelete, "/cancel-all", EndpointGroupCancels},
+		{http.MethodDelete, "/cancel-market-orders", EndpointGroupCancels},
+		{http.MethodGet, "/book?token_id=1", EndpointGroupBookReads},
+		{http.MethodPost, "/books", EndpointGroupBookReads},
+		{http.MethodGet, "/midpoint?token_id=1", EndpointGroupBookReads},
+		{http.MethodGet, "/tick-size?token_id=1", EndpointGroupBookReads},
+		{http.MethodGet, "/fee-rate?token_id=1", EndpointGroupBookReads},
+		{http.MethodGet, "/data/order/0xabc", EndpointGroupDefault},
+		{http.MethodGet, "/data/orders", EndpointGroupDefault},
+		{http.MethodGet, "/time", EndpointGroupDefault},
+	}
+	for _, tt := range tests {
+		if got := clobEndpointGroup(tt.method, tt.endpoint); got != tt.want {
+			t.Errorf("clobEndpointGroup(%s %s) = %s, want %s", tt.method, tt.endpoint, got, tt.want)
+		}
+	}
+}
+
+// TestRequestEndpointGroups checks the rate limit group of requests to each API
+func TestRequestEndpointGroups(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	var (
+		mu     sync.Mutex
+		groups []EndpointGroup
+	)
+	record := func(next Handler) Handler {
+		return func(req *http.Request, info *RequestInfo) (*http.Response, error) {
+			mu.Lock()
+			groups = append(groups, info.Group)
+			mu.Unlock()
+			return next(req, info)
+		}
+	}
+	c := newTestClient(t, server.URL, func(config *Config) {
+		config.Interceptors = []Interceptor{record}
+	})
+
+	ctx := context.Background()
+	c.GetCtx(ctx, "/book?token_id=1")
+	c.PostCtx(ctx, "/order", struct{}{})
+	c.GetCtx(ctx, "/data/trades")
+	c.GammaClient().GetCtx(ctx, "/markets?closed=false")
+	c.DataClient().GetCtx(ctx, "/positions?user=0x1")
+
+	want := []EndpointGroup{EndpointGroupBookReads, EndpointGroupOrderPlacement, EndpointGroupDefault, EndpointGroupGammaReads, EndpointGroupDataReads}
+	if len(groups) != len(want) {
+		t.Fatalf("groups = %v, want %v", groups, want)
+	}
+	for i := range want {
+		if groups[i] != want[i] {
+			t.Errorf("request %d: group %s, want %s", i+1, groups[i], want[i])
+		}
+	}
+}
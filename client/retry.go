@@ -0,0 +1,110 @@
+package client
+
+import (
+	"math"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how failed requests are retried
+//
+// Idempotent requests (GET) are retried on network errors and retryable status codes.
+// Non-idempotent requests (POST /order, DELETE cancels, ...) are only retried when the
+// request provably never reached the server, i.e. no connection was ever established.
+type RetryPolicy struct {
+	MaxAttempts       int                       // Total attempts including the first one, <= 1 disables retries
+	InitialBackoff    time.Duration             // Backoff before the first retry
+	MaxBackoff        time.Duration             // Upper bound for the exponential backoff
+	Multiplier        float64                   // Backoff growth factor per attempt
+	Jitter            float64                   // Random jitter as a fraction of the backoff (0-1)
+	RespectRetryAfter bool                      // Wait for the Retry-After header on 429/503 responses
+	RetryableStatus   func(statusCode int) bool // Status codes worth retrying, default DefaultRetryableStatus
+}
+
+// DefaultRetryPolicy returns the retry policy used when Config.RetryPolicy is nil
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:       3,
+		InitialBackoff:    200 * time.Millisecond,
+		MaxBackoff:        5 * time.Second,
+		Multiplier:        2,
+		Jitter:            0.2,
+		RespectRetryAfter: true,
+		RetryableStatus:   DefaultRetryableStatus,
+	}
+}
+
+// NoRetryPolicy returns a policy that never retries
+func NoRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{MaxAttempts: 1}
+}
+
+// DefaultRetryableStatus reports whether a status code is transient (429 and 5xx gateway/availability errors)
+func DefaultRetryableStatus(statusCode int) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// isRetryableStatus reports whether the policy retries the status code
+func (p *RetryPolicy) isRetryableStatus(statusCode int) bool {
+	if p.RetryableStatus == nil {
+		return DefaultRetryableStatus(statusCode)
+	}
+	return p.RetryableStatus(statusCode)
+}
+
+// backoff returns the delay before the given retry (1 for the first retry)
+// retryAfter is the server-provided delay, if any
+func (p *RetryPolicy) backoff(retry int, retryAfter time.Duration) time.Duration {
+	delay := float64(p.InitialBackoff)
+	if p.Multiplier > 1 {
+		delay *= math.Pow(p.Multiplier, float64(retry-1))
+	}
+	if p.MaxBackoff > 0 && delay > float64(p.MaxBackoff) {
+		delay = float64(p.MaxBackoff)
+	}
+	if p.Jitter > 0 {
+		delay += delay * p.Jitter * (2*rand.Float64() - 1)
+	}
+
+	d := time.Duration(delay)
+	if p.RespectRetryAfter && retryAfter > d {
+		d = retryAfter
+	}
+	return d
+}
+
+// isIdempotent reports whether a request with the given method can be safely repeated
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+	return false
+}
+
+// parseRetryAfter parses a Retry-After header value (delay in seconds or HTTP date)
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(value); err == nil && t.After(now) {
+		return t.Sub(now)
+	}
+	return 0
+}
@@ -0,0 +1,218 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/mtt-labs/poly-market-sdk/auth"
+)
+
+// fastRetryPolicy retries up to 3 attempts with a negligible backoff
+func fastRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:       3,
+		InitialBackoff:    time.Millisecond,
+		MaxBackoff:        time.Millisecond,
+		Multiplier:        2,
+		RespectRetryAfter: true,
+	}
+}
+
+func withRetryPolicy(policy *RetryPolicy) func(*Config) {
+	return func(c *Config) { c.RetryPolicy = policy }
+}
+
+func TestPostNotRetriedAfterConnectionClosed(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		// The request reached the server, which drops the connection without answering
+		conn, _, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			t.Errorf("hijack: %v", err)
+			return
+		}
+		conn.Close()
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL, withRetryPolicy(fastRetryPolicy()))
+	if _, err := c.PostCtx(context.Background(), "/order", map[string]string{"id": "1"}); err == nil {
+		t.Fatal("PostCtx expected an error")
+	}
+	if n := requests.Load(); n != 1 {
+		t.Errorf("POST sent %d times, want 1", n)
+	}
+
+	// A GET is retried on the same failure
+	requests.Store(0)
+	if _, err := c.GetCtx(context.Background(), "/book"); err == nil {
+		t.Fatal("GetCtx expected an error")
+	}
+	if n := requests.Load(); n != 3 {
+		t.Errorf("GET sent %d times, want 3", n)
+	}
+}
+
+func TestRetryableStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		method   string
+		statuses []int // Status of each attempt, the last one repeats
+		want     int   // Expected attempts
+		wantErr  bool
+	}{
+		{"GET retried on 502 and 503", http.MethodGet, []int{502, 503, 200}, 3, false},
+		{"GET gives up after MaxAttempts", http.MethodGet, []int{503}, 3, true},
+		{"GET not retried on 400", http.MethodGet, []int{400}, 1, true},
+		{"POST not retried on 503", http.MethodPost, []int{503, 200}, 1, true},
+	}
+	for _, tt := range tests {
+		var requests atomic.Int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			n := int(requests.Add(1))
+			w.WriteHeader(tt.statuses[min(n, len(tt.statuses))-1])
+			w.Write([]byte(`{}`))
+		}))
+
+		c := newTestClient(t, server.URL, withRetryPolicy(fastRetryPolicy()))
+		var err error
+		if tt.method == http.MethodGet {
+			_, err = c.GetCtx(context.Background(), "/book")
+		} else {
+			_, err = c.PostCtx(context.Background(), "/order", map[string]string{"id": "1"})
+		}
+		server.Close()
+
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: error = %v, want error %v", tt.name, err, tt.wantErr)
+		}
+		if n := int(requests.Load()); n != tt.want {
+			t.Errorf("%s: %d attempts, want %d", tt.name, n, tt.want)
+		}
+	}
+}
+
+// TestRetryResignsL2Headers checks that a retry waits for Retry-After and signs
+// the L2 headers again, with the timestamp of the new attempt
+func TestRetryResignsL2Headers(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		headers []*auth.L2AuthHeaders
+		times   []time.Time
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		headers = append(headers, &auth.L2AuthHeaders{
+			Address:    r.Header.Get("POLY_ADDRESS"),
+			Signature:  r.Header.Get("POLY_SIGNATURE"),
+			Timestamp:  r.Header.Get("POLY_TIMESTAMP"),
+			APIKey:     r.Header.Get("POLY_API_KEY"),
+			Passphrase: r.Header.Get("POLY_PASSPHRASE"),
+		})
+		times = append(times, time.Now())
+		first := len(headers) == 1
+		mu.Unlock()
+
+		if first {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	creds := credentialSet(1)
+	c := newTestClient(t, server.URL, withRetryPolicy(fastRetryPolicy()), func(config *Config) {
+		config.APIKey, config.APISecret, config.APIPassphrase = creds.Key, creds.Secret, creds.Passphrase
+	})
+	if _, err := c.DoWithL2Ctx(context.Background(), http.MethodGet, "/data/orders", nil); err != nil {
+		t.Fatalf("DoWithL2Ctx: %v", err)
+	}
+
+	if len(headers) != 2 {
+		t.Fatalf("%d attempts, want 2", len(headers))
+	}
+	if wait := times[1].Sub(times[0]); wait < time.Second {
+		t.Errorf("retried after %v, want at least the Retry-After of 1s", wait)
+	}
+	if headers[0].Timestamp == headers[1].Timestamp {
+		t.Errorf("both attempts signed with timestamp %s, want a fresh one", headers[0].Timestamp)
+	}
+	for i, h := range headers {
+		if err := checkConsistent(h, http.MethodGet, "/data/orders", ""); err != nil {
+			t.Errorf("attempt %d: %v", i+1, err)
+		}
+	}
+}
+
+func TestBackoffBounds(t *testing.T) {
+	policy := &RetryPolicy{
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     time.Second,
+		Multiplier:     2,
+		Jitter:         0.2,
+	}
+	for retry := 1; retry <= 8; retry++ {
+		base := min(100*time.Millisecond<<(retry-1), time.Second)
+		low, high := time.Duration(float64(base)*0.8), time.Duration(float64(base)*1.2)
+		for range 100 {
+			if d := policy.backoff(retry, 0); d < low || d > high {
+				t.Fatalf("retry %d: backoff %v, want within [%v, %v]", retry, d, low, high)
+			}
+		}
+	}
+
+	// Retry-After only extends the backoff when the policy respects it
+	if d := policy.backoff(1, 10*time.Second); d > 120*time.Millisecond {
+		t.Errorf("ignored Retry-After: backoff %v", d)
+	}
+	policy.RespectRetryAfter = true
+	if d := policy.backoff(1, 10*time.Second); d != 10*time.Second {
+		t.Errorf("Retry-After 10s: backoff %v", d)
+	}
+	if d := policy.backoff(1, time.Millisecond); d < 80*time.Millisecond {
+		t.Errorf("short Retry-After: backoff %v, want the policy backoff", d)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)
+	tests := []struct {
+		value string
+		want  time.Duration
+	}{
+		{"", 0},
+		{"0", 0},
+		{"7", 7 * time.Second},
+		{"-3", 0},
+		{"soon", 0},
+		{now.Add(30 * time.Second).Format(http.TimeFormat), 30 * time.Second},
+		{now.Add(-time.Minute).Format(http.TimeFormat), 0},
+		{now.Add(2 * time.Minute).Format(time.RFC850), 2 * time.Minute},
+	}
+	for _, tt := range tests {
+		if got := parseRetryAfter(tt.value, now); got != tt.want {
+			t.Errorf("parseRetryAfter(%q) = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestDefaultRetryableStatus(t *testing.T) {
+	for _, status := range []int{429, 500, 502, 503, 504} {
+		if !DefaultRetryableStatus(status) {
+			t.Errorf("status %d not retryable", status)
+		}
+	}
+	for _, status := range []int{200, 400, 401, 403, 404, 501} {
+		if DefaultRetryableStatus(status) {
+			t.Errorf("status %d retryable", status)
+		}
+	}
+}
@@ -0,0 +1,202 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName OpenTelemetry instrumentation scope of the SDK
+const instrumentationName = "github.com/mtt-labs/poly-market-sdk"
+
+// Error classes reported in spans and metrics
+const (
+	ErrorClassRateLimited = "rate_limited"
+	ErrorClassAuth        = "auth"
+	ErrorClassClient      = "client_error"
+	ErrorClassServer      = "server_error"
+	ErrorClassOrder       = "order_rejected"
+	ErrorClassNetwork     = "network"
+	ErrorClassTimeout     = "timeout"
+	ErrorClassCanceled    = "canceled"
+	ErrorClassNoSigner    = "no_signer"
+	ErrorClassOther       = "other"
+)
+
+// Telemetry OpenTelemetry instrumentation of API calls
+// With no provider configured it uses the global OpenTelemetry providers,
+// which are no-ops unless the application registers its own
+type Telemetry struct {
+	tracer         trace.Tracer
+	duration       metric.Float64Histogram
+	errors         metric.Int64Counter
+	orderPlacement metric.Int64Counter
+}
+
+// newTelemetry creates the instruments from the given providers (nil uses the global providers)
+func newTelemetry(tracerProvider trace.TracerProvider, meterProvider metric.MeterProvider) *Telemetry {
+	if tracerProvider == nil {
+		tracerProvider = otel.GetTracerProvider()
+	}
+	if meterProvider == nil {
+		meterProvider = otel.GetMeterProvider()
+	}
+
+	meter := meterProvider.Meter(instrumentationName)
+	t := &Telemetry{tracer: tracerProvider.Tracer(instrumentationName)}
+
+	// Instrument creation only fails on invalid names, the returned instruments are always usable
+	t.duration, _ = meter.Float64Histogram("polymarket.client.request.duration",
+		metric.WithDescription("Duration of Polymarket API calls, including retries"),
+		metric.WithUnit("s"))
+	t.errors, _ = meter.Int64Counter("polymarket.client.request.errors",
+		metric.WithDescription("Failed Polymarket API calls by error class"),
+		metric.WithUnit("{error}"))
+	t.orderPlacement, _ = meter.Int64Counter("polymarket.client.order.placements",
+		metric.WithDescription("Order placement outcomes by status"),
+		metric.WithUnit("{order}"))
+
+	return t
+}
+
+// startCall starts the span of an API call
+func (t *Telemetry) startCall(ctx context.Context, api API, method, endpoint string, authLevel AuthLevel) (context.Context, trace.Span) {
+	template := endpointTemplate(endpoint)
+	return t.tracer.Start(ctx, "polymarket."+string(api)+" "+method+" "+template,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("polymarket.api", string(api)),
+			attribute.String("http.request.method", method),
+			attribute.String("polymarket.endpoint", template),
+			attribute.String("polymarket.auth_level", authLevel.String()),
+		),
+	)
+}
+
+// endCall ends the span of an API call and records its metrics
+func (t *Telemetry) endCall(ctx context.Context, span trace.Span, api API, method, endpoint string, start time.Time, attempts, statusCode int, err error) {
+	attrs := []attribute.KeyValue{
+		attribute.String("polymarket.api", string(api)),
+		attribute.String("http.request.method", method),
+		attribute.String("polymarket.endpoint", endpointTemplate(endpoint)),
+	}
+	if statusCode > 0 {
+		attrs = append(attrs, attribute.Int("http.response.status_code", statusCode))
+	}
+
+	span.SetAttributes(attribute.Int("polymarket.retries", attempts-1))
+	if statusCode > 0 {
+		span.SetAttributes(attribute.Int("http.response.status_code", statusCode))
+	}
+
+	if err != nil {
+		class := ErrorClass(err)
+		attrs = append(attrs, attribute.String("error.type", class))
+		span.SetAttributes(attribute.String("error.type", class))
+		span.RecordError(err)
+		span.SetStatus(codes.Error, class)
+		t.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+	}
+	span.End()
+
+	t.duration.Record(ctx, time.Since(start).Seconds(), metric.WithAttributes(attrs...))
+}
+
+// RecordOrderPlacement records the outcome of an order placement
+// status is the CreateOrderResponse status ("matched", "live", "delayed", "unmatched"),
+// failed placements are recorded with their error class
+func (t *Telemetry) RecordOrderPlacement(ctx context.Context, orderType, status string, err error) {
+	outcome := status
+	if err != nil {
+		outcome = ErrorClass(err)
+	}
+	if outcome == "" {
+		outcome = "unknown"
+	}
+
+	t.orderPlacement.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("polymarket.order_type", orderType),
+		attribute.String("polymarket.order_status", outcome),
+	))
+	trace.SpanFromContext(ctx).AddEvent("polymarket.order_placement", trace.WithAttributes(
+		attribute.String("polymarket.order_type", orderType),
+		attribute.String("polymarket.order_status", outcome),
+	))
+}
+
+// ErrorClass classifies an error for telemetry (one of the ErrorClass* constants)
+func ErrorClass(err error) string {
+	var apiErr *APIError
+	var netErr net.Error
+	switch {
+	case err == nil:
+		return ""
+	case errors.Is(err, ErrRateLimited):
+		return ErrorClassRateLimited
+	case errors.Is(err, ErrAuth):
+		return ErrorClassAuth
+	case errors.Is(err, ErrNoSigner):
+		return ErrorClassNoSigner
+	case errors.Is(err, context.Canceled):
+		return ErrorClassCanceled
+	case errors.Is(err, context.DeadlineExceeded):
+		return ErrorClassTimeout
+	case errors.As(err, &apiErr):
+		switch {
+		case apiErr.StatusCode >= 500:
+			return ErrorClassServer
+		case apiErr.StatusCode >= 400:
+			return ErrorClassClient
+		default:
+			return ErrorClassOrder
+		}
+	case errors.As(err, &netErr):
+		if netErr.Timeout() {
+			return ErrorClassTimeout
+		}
+		return ErrorClassNetwork
+	}
+	return ErrorClassOther
+}
+
+// endpointTemplate strips the query string and replaces identifiers in an endpoint path,
+// e.g. "/orders/0xabc?x=1" becomes "/orders/{id}", to keep span names and metric attributes low-cardinality
+func endpointTemplate(endpoint string) string {
+	path, _, _ := strings.Cut(endpoint, "?")
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		switch {
+		case i > 0 && segments[i-1] == "slug":
+			segments[i] = "{slug}"
+		case i > 1 && segments[i-2] == "events" && segments[i-1] == "tags":
+			segments[i] = "{slug}"
+		case isIdentifier(segment):
+			segments[i] = "{id}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// isIdentifier reports whether a path segment looks like an ID (numeric, hex or long opaque value)
+func isIdentifier(segment string) bool {
+	if segment == "" {
+		return false
+	}
+	if strings.HasPrefix(segment, "0x") || len(segment) > 32 {
+		return true
+	}
+	for _, r := range segment {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
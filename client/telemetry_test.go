@@ -0,0 +1,204 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+const testOrderID = "0x3f1c1e0d6a4b2e7c9d8f5a6b1c2d3e4f5a6b7c8d9e0f1a2b3c4d5e6f7a8b9c0d"
+
+// timeoutError net.Error reporting a timeout
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func TestErrorClass(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{"nil", nil, ""},
+		{"429", &APIError{StatusCode: http.StatusTooManyRequests}, ErrorClassRateLimited},
+		{"local rate limit", fmt.Errorf("book reads: %w", ErrRateLimited), ErrorClassRateLimited},
+		{"401", &APIError{StatusCode: http.StatusUnauthorized}, ErrorClassAuth},
+		{"403", &APIError{StatusCode: http.StatusForbidden}, ErrorClassAuth},
+		{"404", &APIError{StatusCode: http.StatusNotFound}, ErrorClassClient},
+		{"400 order error", &APIError{StatusCode: http.StatusBadRequest, Code: ErrorCodeInvalidOrderMinSize}, ErrorClassClient},
+		{"502", &APIError{StatusCode: http.StatusBadGateway}, ErrorClassServer},
+		{"rejected in a 200 response", &APIError{StatusCode: http.StatusOK, Code: ErrorCodeFOKOrderNotFilled}, ErrorClassOrder},
+		{"wrapped API error", fmt.Errorf("post order: %w", &APIError{StatusCode: http.StatusServiceUnavailable}), ErrorClassServer},
+		{"no signer", fmt.Errorf("create order: %w", ErrNoSigner), ErrorClassNoSigner},
+		{"canceled", fmt.Errorf("wait: %w", context.Canceled), ErrorClassCanceled},
+		{"deadline", context.DeadlineExceeded, ErrorClassTimeout},
+		{"net timeout", &net.OpError{Op: "read", Net: "tcp", Err: timeoutError{}}, ErrorClassTimeout},
+		{"connection refused", &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}, ErrorClassNetwork},
+		{"other", errors.New("invalid order"), ErrorClassOther},
+	}
+	for _, tt := range tests {
+		if got := ErrorClass(tt.err); got != tt.want {
+			t.Errorf("%s: ErrorClass(%v) = %q, want %q", tt.name, tt.err, got, tt.want)
+		}
+	}
+}
+
+func TestEndpointTemplate(t *testing.T) {
+	tests := []struct {
+		endpoint string
+		want     string
+	}{
+		{"", ""},
+		{"/book", "/book"},
+		{"/book?token_id=71321045679252212594626385532706912750332728571942532289631379312455583992563", "/book"},
+		{"/data/order/" + testOrderID, "/data/order/{id}"},
+		{"/data/order/" + strings.TrimPrefix(testOrderID, "0x"), "/data/order/{id}"},
+		{"/data/orders?market=0xabc&asset_id=123", "/data/orders"},
+		{"/markets/12345", "/markets/{id}"},
+		{"/markets/slug/will-it-rain-tomorrow", "/markets/slug/{slug}"},
+		{"/events/tags/politics", "/events/tags/{slug}"},
+		{"/positions?user=0x1", "/positions"},
+	}
+	for _, tt := range tests {
+		if got := endpointTemplate(tt.endpoint); got != tt.want {
+			t.Errorf("endpointTemplate(%q) = %q, want %q", tt.endpoint, got, tt.want)
+		}
+	}
+}
+
+// collectAttributes returns the data point attributes of each metric
+func collectAttributes(t *testing.T, reader sdkmetric.Reader) map[string][]attribute.Set {
+	t.Helper()
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatalf("collect metrics: %v", err)
+	}
+	attrs := make(map[string][]attribute.Set)
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			switch data := m.Data.(type) {
+			case metricdata.Histogram[float64]:
+				for _, dp := range data.DataPoints {
+					attrs[m.Name] = append(attrs[m.Name], dp.Attributes)
+				}
+			case metricdata.Sum[int64]:
+				for _, dp := range data.DataPoints {
+					attrs[m.Name] = append(attrs[m.Name], dp.Attributes)
+				}
+			}
+		}
+	}
+	return attrs
+}
+
+// TestTelemetryEndpointLabels checks that spans and metrics of API calls are labelled with
+// endpoint templates, so that order IDs and token IDs never end up in span names or metric attributes
+func TestTelemetryEndpointLabels(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/data/order/") {
+			http.Error(w, `{"error":"order not found"}`, http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	recorder := tracetest.NewSpanRecorder()
+	reader := sdkmetric.NewManualReader()
+	c := newTestClient(t, server.URL, func(config *Config) {
+		config.TracerProvider = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+		config.MeterProvider = sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	})
+
+	ctx := context.Background()
+	if _, err := c.GetCtx(ctx, "/book?token_id=123456"); err != nil {
+		t.Fatalf("GetCtx: %v", err)
+	}
+	if _, err := c.GetCtx(ctx, "/data/order/"+testOrderID); err == nil {
+		t.Fatal("GetCtx expected an error")
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("%d spans, want 2", len(spans))
+	}
+	wantNames := []string{"polymarket.clob GET /book", "polymarket.clob GET /data/order/{id}"}
+	for i, span := range spans {
+		if span.Name() != wantNames[i] {
+			t.Errorf("span %d: name %q, want %q", i+1, span.Name(), wantNames[i])
+		}
+		for _, kv := range span.Attributes() {
+			if value := kv.Value.Emit(); strings.Contains(value, testOrderID) || strings.Contains(value, "123456") {
+				t.Errorf("span %q: attribute %s = %q leaks an ID", span.Name(), kv.Key, value)
+			}
+		}
+	}
+	failed := spans[1]
+	if failed.Status().Code != codes.Error || failed.Status().Description != ErrorClassClient {
+		t.Errorf("failed span status = %+v, want an error with class %s", failed.Status(), ErrorClassClient)
+	}
+
+	metrics := collectAttributes(t, reader)
+	wantEndpoints := map[string][]string{
+		"polymarket.client.request.duration": {"/book", "/data/order/{id}"},
+		"polymarket.client.request.errors":   {"/data/order/{id}"},
+	}
+	for name, want := range wantEndpoints {
+		var got []string
+		for _, set := range metrics[name] {
+			endpoint, _ := set.Value("polymarket.endpoint")
+			got = append(got, endpoint.AsString())
+			for _, kv := range set.ToSlice() {
+				if value := kv.Value.Emit(); strings.Contains(value, testOrderID) || strings.Contains(value, "123456") {
+					t.Errorf("%s: attribute %s = %q leaks an ID", name, kv.Key, value)
+				}
+			}
+			if name == "polymarket.client.request.errors" {
+				if class, _ := set.Value("error.type"); class.AsString() != ErrorClassClient {
+					t.Errorf("%s: error.type = %q, want %s", name, class.AsString(), ErrorClassClient)
+				}
+			}
+		}
+		slices.Sort(got)
+		if strings.Join(got, ",") != strings.Join(want, ",") {
+			t.Errorf("%s: endpoints %v, want %v", name, got, want)
+		}
+	}
+}
+
+func TestRecordOrderPlacement(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	telemetry := newTelemetry(sdktrace.NewTracerProvider(), sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)))
+
+	ctx := context.Background()
+	telemetry.RecordOrderPlacement(ctx, "GTC", "live", nil)
+	telemetry.RecordOrderPlacement(ctx, "FOK", "", &APIError{StatusCode: http.StatusOK, Code: ErrorCodeFOKOrderNotFilled})
+	telemetry.RecordOrderPlacement(ctx, "GTC", "", nil)
+
+	var got []string
+	for _, set := range collectAttributes(t, reader)["polymarket.client.order.placements"] {
+		orderType, _ := set.Value("polymarket.order_type")
+		status, _ := set.Value("polymarket.order_status")
+		got = append(got, orderType.AsString()+" "+status.AsString())
+	}
+	want := []string{"FOK order_rejected", "GTC live", "GTC unknown"}
+	slices.Sort(got)
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("order placements %v, want %v", got, want)
+	}
+}
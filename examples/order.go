@@ -3,6 +3,7 @@ package main
 import (
 	"fmt"
 	"log"
+	"os"
 
 	"github.com/mtt-labs/poly-market-sdk/api"
 	"github.com/mtt-labs/poly-market-sdk/client"
@@ -17,14 +18,22 @@ func main() {
 		log.Fatal("Please set POLYMARKET_PRIVATE_KEY environment variable")
 	}
 
+	// Persist API credentials across restarts in an encrypted file
+	credentialStore, err := client.NewFileCredentialStore("polymarket-credentials.json", os.Getenv("POLYMARKET_CREDENTIALS_PASSPHRASE"))
+	if err != nil {
+		log.Fatalf("Failed to create credential store: %v", err)
+	}
+
 	// Create client configuration
 	// Reference: https://docs.polymarket.com/quickstart/orders/first-order
 	config := &client.Config{
 		PrivateKey:    privateKey,
-		ChainID:       137,                                          // Polygon mainnet
-		SignatureType: client.SignatureTypeEmailMagic,               // 0=EOA, 1=Email/Magic, 2=Browser Wallet
-		Funder:        "0x47D348AD8bEB6F1259F5326859e9F185C28B0348", // This is the address listed below your profile picture when using the Polymarket site.
-		// Funder: "0x...", // If using Proxy, set this address
+		ChainID:       137,                            // Polygon mainnet
+		SignatureType: client.SignatureTypeEmailMagic, // 0=EOA, 1=Email/Magic, 2=Browser Wallet
+		// Funder is derived from the key and SignatureType (proxy wallet or Gnosis Safe),
+		// set it only to check it against the address listed below your profile picture
+		CredentialStore:        credentialStore, // Credentials are loaded on startup and saved after create/derive
+		AutoRefreshCredentials: true,            // Re-derive credentials if the key is rotated or revoked
 	}
 
 	// Create SDK instance
@@ -45,22 +54,22 @@ func main() {
 	}
 	fmt.Printf("Found %d markets\n", len(markets))
 
-	// Create or derive API credentials (required for first-time use)
-	creds, err := sdk.Auth.DeriveAPICredentials()
-	if err != nil {
-		log.Printf("Failed to get API credentials: %v", err)
-		log.Println("Note: Some operations may require creating API credentials first")
-	} else {
-		fmt.Printf("API Key: %s\n", creds.Key)
+	// Create or derive API credentials (only required for first-time use, then they are loaded from the store)
+	if sdk.Client.GetAPIKey() == "" {
+		creds, err := sdk.Auth.CreateOrDeriveAPICredentials()
+		if err != nil {
+			log.Printf("Failed to get API credentials: %v", err)
+			log.Println("Note: Some operations may require creating API credentials first")
+		} else {
+			fmt.Printf("API Key: %s\n", creds)
+		}
 	}
 
-	sdk.Client.SetAPICredentials(creds.Key, creds.Secret, creds.Passphrase)
-
 	//orderResp, err := sdk.Orders.CreateAndPostOrder(&models.CreateAndPostOrderParams{
 	//	TokenID: "82202994941777288823087700378947997402758931782856358751785693028970403111094", // ERC1155 token ID
-	//	Price:   0.5,                                                                             // Order price
+	//	Price:   models.MustDecimal("0.5"),                                                       // Order price (exact decimal)
 	//	Side:    0,                                                                               // 0=BUY, 1=SELL
-	//	Size:    5,
+	//	Size:    models.MustDecimal("5"),
 	//}, &models.CreateAndPostOrderConfig{}, models.OrderTypeGTC)
 	//if err != nil {
 	//	log.Printf("Failed to create order: %v", err)
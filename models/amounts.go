@@ -0,0 +1,75 @@
+package models
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// CollateralDecimals decimals of USDC and of the conditional tokens, order amounts are in these units
+const CollateralDecimals = 6
+
+// RoundingConfig decimal places of the price, size and USDC amount of an order for a tick size
+// Reference: https://github.com/Polymarket/clob-client (ROUNDING_CONFIG)
+type RoundingConfig struct {
+	Price  int32 // Price decimal places (those of the tick size)
+	Size   int32 // Size decimal places
+	Amount int32 // USDC amount decimal places
+}
+
+// roundingConfigs rounding configs of the tick sizes supported by the CLOB
+var roundingConfigs = map[string]RoundingConfig{
+	"0.1":    {Price: 1, Size: 2, Amount: 3},
+	"0.01":   {Price: 2, Size: 2, Amount: 4},
+	"0.001":  {Price: 3, Size: 2, Amount: 5},
+	"0.0001": {Price: 4, Size: 2, Amount: 6},
+}
+
+// RoundingConfigForTickSize returns the rounding config of a tick size ("0.1", "0.01", "0.001" or "0.0001")
+func RoundingConfigForTickSize(tickSize string) (RoundingConfig, error) {
+	tick, err := NewDecimalFromString(tickSize)
+	if err != nil {
+		return RoundingConfig{}, fmt.Errorf("invalid tick size: %w", err)
+	}
+	config, ok := roundingConfigs[tick.String()]
+	if !ok {
+		return RoundingConfig{}, fmt.Errorf("unsupported tick size: %s", tickSize)
+	}
+	return config, nil
+}
+
+// OrderAmounts maker and taker amounts of an order in token units (CollateralDecimals)
+type OrderAmounts struct {
+	Price       Decimal  // Price rounded to the tick size
+	Size        Decimal  // Size in shares rounded to the size decimals
+	MakerAmount *big.Int // Amount the maker gives: USDC for a buy, shares for a sell
+	TakerAmount *big.Int // Amount the maker receives: shares for a buy, USDC for a sell
+}
+
+// LimitOrderAmounts computes the amounts of a limit order like clob-client getOrderRawAmounts:
+// the price is rounded to the tick size, the size is rounded down to 2 decimals and the USDC
+// amount (price * size) is rounded down to the amount decimals of the tick size
+func LimitOrderAmounts(side OrderSide, price, size Decimal, config RoundingConfig) *OrderAmounts {
+	roundedPrice := price.Round(config.Price)
+	shares := size.RoundDown(config.Size)
+	usdc := roundAmount(shares.Mul(roundedPrice), config)
+
+	amounts := &OrderAmounts{Price: roundedPrice, Size: shares}
+	if side == OrderSideBuy {
+		amounts.MakerAmount = usdc.Units(CollateralDecimals)
+		amounts.TakerAmount = shares.Units(CollateralDecimals)
+	} else {
+		amounts.MakerAmount = shares.Units(CollateralDecimals)
+		amounts.TakerAmount = usdc.Units(CollateralDecimals)
+	}
+	return amounts
+}
+
+// roundAmount rounds an amount with more decimals than allowed like clob-client: round up to
+// Amount+4 decimals (which absorbs the float error there) then down to Amount decimals.
+// Products of a rounded price and size are exact here, so this is a plain round down.
+func roundAmount(amount Decimal, config RoundingConfig) Decimal {
+	if amount.DecimalPlaces() <= config.Amount {
+		return amount
+	}
+	return amount.RoundUp(config.Amount + 4).RoundDown(config.Amount)
+}
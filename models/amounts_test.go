@@ -0,0 +1,203 @@
+package models
+
+import (
+	"testing"
+)
+
+func TestRoundingConfigForTickSize(t *testing.T) {
+	tests := []struct {
+		tickSize string
+		want     RoundingConfig
+	}{
+		{"0.1", RoundingConfig{Price: 1, Size: 2, Amount: 3}},
+		{"0.01", RoundingConfig{Price: 2, Size: 2, Amount: 4}},
+		{"0.001", RoundingConfig{Price: 3, Size: 2, Amount: 5}},
+		{"0.0001", RoundingConfig{Price: 4, Size: 2, Amount: 6}},
+		{"0.010", RoundingConfig{Price: 2, Size: 2, Amount: 4}},
+	}
+	for _, tt := range tests {
+		got, err := RoundingConfigForTickSize(tt.tickSize)
+		if err != nil {
+			t.Errorf("RoundingConfigForTickSize(%q) error: %v", tt.tickSize, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("RoundingConfigForTickSize(%q) = %+v, want %+v", tt.tickSize, got, tt.want)
+		}
+	}
+
+	for _, tickSize := range []string{"0.05", "1", "0.00001", "abc", ""} {
+		if _, err := RoundingConfigForTickSize(tickSize); err == nil {
+			t.Errorf("RoundingConfigForTickSize(%q) expected an error", tickSize)
+		}
+	}
+}
+
+func TestLimitOrderAmounts(t *testing.T) {
+	tests := []struct {
+		name      string
+		side      OrderSide
+		price     string
+		size      string
+		tickSize  string
+		wantPrice string
+		wantSize  string
+		wantMaker string
+		wantTaker string
+	}{
+		{"tick 0.1 buy", OrderSideBuy, "0.5", "21.04", "0.1", "0.5", "21.04", "10520000", "21040000"},
+		{"tick 0.1 sell", OrderSideSell, "0.5", "21.04", "0.1", "0.5", "21.04", "21040000", "10520000"},
+		{"tick 0.1 amount decimals", OrderSideBuy, "0.5", "1.11", "0.1", "0.5", "1.11", "555000", "1110000"},
+		{"tick 0.01 buy", OrderSideBuy, "0.56", "21.04", "0.01", "0.56", "21.04", "11782400", "21040000"},
+		{"tick 0.01 sell", OrderSideSell, "0.56", "21.04", "0.01", "0.56", "21.04", "21040000", "11782400"},
+		{"tick 0.01 buy 0.82x101", OrderSideBuy, "0.82", "101", "0.01", "0.82", "101", "82820000", "101000000"},
+		{"tick 0.01 sell 0.82x101", OrderSideSell, "0.82", "101", "0.01", "0.82", "101", "101000000", "82820000"},
+		{"tick 0.001 buy", OrderSideBuy, "0.056", "21.04", "0.001", "0.056", "21.04", "1178240", "21040000"},
+		{"tick 0.001 sell", OrderSideSell, "0.056", "21.04", "0.001", "0.056", "21.04", "21040000", "1178240"},
+		{"tick 0.0001 buy", OrderSideBuy, "0.0056", "21.04", "0.0001", "0.0056", "21.04", "117824", "21040000"},
+		{"tick 0.0001 sell", OrderSideSell, "0.0056", "21.04", "0.0001", "0.0056", "21.04", "21040000", "117824"},
+		{"price rounded half up", OrderSideBuy, "0.555", "10", "0.01", "0.56", "10", "5600000", "10000000"},
+		{"price rounded down", OrderSideBuy, "0.5549", "10", "0.01", "0.55", "10", "5500000", "10000000"},
+		{"size rounded down", OrderSideSell, "0.56", "21.049", "0.01", "0.56", "21.04", "21040000", "11782400"},
+	}
+	for _, tt := range tests {
+		config, err := RoundingConfigForTickSize(tt.tickSize)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		got := LimitOrderAmounts(tt.side, MustDecimal(tt.price), MustDecimal(tt.size), config)
+		if got.Price.String() != tt.wantPrice || got.Size.String() != tt.wantSize {
+			t.Errorf("%s: price, size = %s, %s, want %s, %s", tt.name, got.Price, got.Size, tt.wantPrice, tt.wantSize)
+		}
+		if got.MakerAmount.String() != tt.wantMaker || got.TakerAmount.String() != tt.wantTaker {
+			t.Errorf("%s: maker, taker = %s, %s, want %s, %s", tt.name, got.MakerAmount, got.TakerAmount, tt.wantMaker, tt.wantTaker)
+		}
+	}
+}
+
+func TestLimitOrderAmountsFromFloats(t *testing.T) {
+	// float64 gives 0.56*21.04 = 11.782399999999999 and 0.82*101 = 82.82000000000001
+	tests := []struct {
+		side      OrderSide
+		price     float64
+		size      float64
+		wantMaker string
+		wantTaker string
+	}{
+		{OrderSideBuy, 0.56, 21.04, "11782400", "21040000"},
+		{OrderSideSell, 0.56, 21.04, "21040000", "11782400"},
+		{OrderSideBuy, 0.82, 101, "82820000", "101000000"},
+		{OrderSideSell, 0.82, 101, "101000000", "82820000"},
+	}
+	config, _ := RoundingConfigForTickSize("0.01")
+	for _, tt := range tests {
+		price, err := NewDecimalFromFloat(tt.price)
+		if err != nil {
+			t.Fatal(err)
+		}
+		size, err := NewDecimalFromFloat(tt.size)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := LimitOrderAmounts(tt.side, price, size, config)
+		if got.MakerAmount.String() != tt.wantMaker || got.TakerAmount.String() != tt.wantTaker {
+			t.Errorf("side %d %vx%v: maker, taker = %s, %s, want %s, %s",
+				tt.side, tt.price, tt.size, got.MakerAmount, got.TakerAmount, tt.wantMaker, tt.wantTaker)
+		}
+	}
+}
+
+func TestRoundAmount(t *testing.T) {
+	tests := []struct {
+		amount   string
+		tickSize string
+		want     string
+	}{
+		// Within the amount decimals: unchanged
+		{"0.555", "0.1", "0.555"},
+		{"11.7824", "0.01", "11.7824"},
+		// Float error beyond Amount+4 decimals is absorbed by the round up
+		{"11.782399999999999", "0.01", "11.7824"},
+		{"82.82000000000001", "0.01", "82.82"},
+		{"1.178239999999999", "0.001", "1.17824"},
+		{"0.117823999999999", "0.0001", "0.117824"},
+		// Digits within Amount+4 decimals are rounded down
+		{"11.78239999", "0.01", "11.7823"},
+		{"0.5559", "0.1", "0.555"},
+	}
+	for _, tt := range tests {
+		config, _ := RoundingConfigForTickSize(tt.tickSize)
+		if got := roundAmount(MustDecimal(tt.amount), config); got.String() != tt.want {
+			t.Errorf("roundAmount(%s, %s) = %s, want %s", tt.amount, tt.tickSize, got, tt.want)
+		}
+	}
+}
+
+func TestMarketOrderAmounts(t *testing.T) {
+	tests := []struct {
+		name      string
+		side      OrderSide
+		amount    string
+		price     string
+		tickSize  string
+		wantPrice string
+		wantSize  string
+		wantMaker string
+		wantTaker string
+	}{
+		{"tick 0.1 buy", OrderSideBuy, "10", "0.5", "0.1", "0.5", "20", "10000000", "20000000"},
+		{"tick 0.1 sell", OrderSideSell, "20", "0.5", "0.1", "0.5", "20", "20000000", "10000000"},
+		// 100/0.56 = 178.571428571..., rounded up at 8 decimals then down to 4
+		{"tick 0.01 buy", OrderSideBuy, "100", "0.56", "0.01", "0.56", "178.5714", "100000000", "178571400"},
+		{"tick 0.01 sell", OrderSideSell, "21.04", "0.56", "0.01", "0.56", "21.04", "21040000", "11782400"},
+		{"tick 0.001 buy", OrderSideBuy, "1", "0.003", "0.001", "0.003", "333.33333", "1000000", "333333330"},
+		{"tick 0.001 sell", OrderSideSell, "21.04", "0.056", "0.001", "0.056", "21.04", "21040000", "1178240"},
+		{"tick 0.0001 buy", OrderSideBuy, "1", "0.0003", "0.0001", "0.0003", "3333.333333", "1000000", "3333333333"},
+		{"tick 0.0001 sell", OrderSideSell, "21.04", "0.0056", "0.0001", "0.0056", "21.04", "21040000", "117824"},
+		{"price rounded down", OrderSideBuy, "10", "0.569", "0.01", "0.56", "17.8571", "10000000", "17857100"},
+		{"amount rounded down", OrderSideSell, "21.049", "0.56", "0.01", "0.56", "21.04", "21040000", "11782400"},
+	}
+	for _, tt := range tests {
+		config, err := RoundingConfigForTickSize(tt.tickSize)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		got, err := MarketOrderAmounts(tt.side, MustDecimal(tt.amount), MustDecimal(tt.price), config)
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if got.Price.String() != tt.wantPrice || got.Size.String() != tt.wantSize {
+			t.Errorf("%s: price, size = %s, %s, want %s, %s", tt.name, got.Price, got.Size, tt.wantPrice, tt.wantSize)
+		}
+		if got.MakerAmount.String() != tt.wantMaker || got.TakerAmount.String() != tt.wantTaker {
+			t.Errorf("%s: maker, taker = %s, %s, want %s, %s", tt.name, got.MakerAmount, got.TakerAmount, tt.wantMaker, tt.wantTaker)
+		}
+	}
+}
+
+func TestMarketOrderAmountsRejectsInvalidInputs(t *testing.T) {
+	config, _ := RoundingConfigForTickSize("0.01")
+	tests := []struct {
+		amount string
+		price  string
+	}{
+		{"10", "0"},
+		{"10", "0.004"},
+		{"10", "-0.5"},
+		{"10", "1"},
+		{"10", "1.5"},
+		{"10", "7"},
+		{"0", "0.5"},
+		{"-10", "0.5"},
+		{"0.009", "0.5"},
+	}
+	for _, tt := range tests {
+		for _, side := range []OrderSide{OrderSideBuy, OrderSideSell} {
+			if got, err := MarketOrderAmounts(side, MustDecimal(tt.amount), MustDecimal(tt.price), config); err == nil {
+				t.Errorf("MarketOrderAmounts(side %d, amount %s, price %s) = %s/%s, want an error",
+					side, tt.amount, tt.price, got.MakerAmount, got.TakerAmount)
+			}
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package models
+
+// OrderSummary a price level of the CLOB orderbook
+// Reference: https://docs.polymarket.com/api-reference/orderbook/get-order-book-summary
+type OrderSummary struct {
+	Price string `json:"price"` // Price level
+	Size  string `json:"size"`  // Total size available at this price level
+}
+
+// OrderBookSummary CLOB orderbook for a token
+// Reference: https://docs.polymarket.com/api-reference/orderbook/get-order-book-summary
+type OrderBookSummary struct {
+	Market       string         `json:"market"`         // Market condition ID
+	AssetID      string         `json:"asset_id"`       // Token ID
+	Timestamp    string         `json:"timestamp"`      // Snapshot timestamp (milliseconds)
+	Hash         string         `json:"hash"`           // Orderbook hash
+	Bids         []OrderSummary `json:"bids"`           // Bid levels
+	Asks         []OrderSummary `json:"asks"`           // Ask levels
+	MinOrderSize string         `json:"min_order_size"` // Minimum order size
+	TickSize     string         `json:"tick_size"`      // Minimum tick size
+	NegRisk      bool           `json:"neg_risk"`       // Whether the market uses the neg risk exchange
+}
+
+// PriceResponse response for getting the best price of a token
+// Reference: https://docs.polymarket.com/api-reference/pricing/get-market-price
+type PriceResponse struct {
+	Price string `json:"price"`
+}
+
+// MidpointResponse response for getting the midpoint price of a token
+// Reference: https://docs.polymarket.com/api-reference/pricing/get-midpoint-price
+type MidpointResponse struct {
+	Mid string `json:"mid"`
+}
+
+// SpreadResponse response for getting the bid-ask spread of a token
+// Reference: https://docs.polymarket.com/api-reference/spreads/get-bid-ask-spreads
+type SpreadResponse struct {
+	Spread string `json:"spread"`
+}
+
+// LastTradePriceResponse response for getting the last trade price of a token
+type LastTradePriceResponse struct {
+	Price string `json:"price"`
+	Side  string `json:"side"`
+}
@@ -0,0 +1,342 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Decimal exact fixed-point decimal number, the value is coefficient * 10^-scale
+// Order prices, sizes and USDC amounts use Decimal so that rounding follows the CLOB rules exactly,
+// float64 arithmetic produces off-by-one-unit amounts that the CLOB rejects. The zero value is 0.
+// Decimal values are immutable, every operation returns a new value.
+type Decimal struct {
+	coef  *big.Int // Coefficient, nil means 0
+	scale int32    // Number of decimal places, >= 0
+}
+
+// NewDecimal creates a Decimal of unscaled * 10^-scale (NewDecimal(55, 2) is 0.55)
+func NewDecimal(unscaled int64, scale int32) Decimal {
+	if scale < 0 {
+		return Decimal{coef: new(big.Int).Mul(big.NewInt(unscaled), pow10(-scale))}
+	}
+	return Decimal{coef: big.NewInt(unscaled), scale: scale}
+}
+
+// NewDecimalFromInt creates a Decimal of an integer
+func NewDecimalFromInt(value int64) Decimal {
+	return NewDecimal(value, 0)
+}
+
+// NewDecimalFromUnits creates a Decimal from an integer amount of token units (e.g. 1500000 USDC units with 6 decimals is 1.5)
+func NewDecimalFromUnits(units *big.Int, decimals int32) Decimal {
+	if units == nil {
+		return Decimal{}
+	}
+	return Decimal{coef: new(big.Int).Set(units), scale: decimals}.rescaleNonNegative()
+}
+
+// NewDecimalFromString parses a decimal string ("0.55", "-12", "1e-3")
+func NewDecimalFromString(s string) (Decimal, error) {
+	input := s
+	s = strings.TrimSpace(s)
+
+	var exponent int64
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		exp, err := strconv.ParseInt(s[i+1:], 10, 32)
+		if err != nil {
+			return Decimal{}, fmt.Errorf("invalid decimal %q: %w", input, err)
+		}
+		exponent = exp
+		s = s[:i]
+	}
+
+	negative := false
+	if s != "" && (s[0] == '-' || s[0] == '+') {
+		negative = s[0] == '-'
+		s = s[1:]
+	}
+
+	intPart, fracPart, _ := strings.Cut(s, ".")
+	digits := intPart + fracPart
+	if digits == "" || strings.Trim(digits, "0123456789") != "" {
+		return Decimal{}, fmt.Errorf("invalid decimal %q", input)
+	}
+
+	coef, ok := new(big.Int).SetString(digits, 10)
+	if !ok {
+		return Decimal{}, fmt.Errorf("invalid decimal %q", input)
+	}
+	if negative {
+		coef.Neg(coef)
+	}
+
+	scale := int64(len(fracPart)) - exponent
+	if scale > math.MaxInt32 || scale < math.MinInt32 {
+		return Decimal{}, fmt.Errorf("invalid decimal %q: exponent out of range", input)
+	}
+	return Decimal{coef: coef, scale: int32(scale)}.rescaleNonNegative(), nil
+}
+
+// MustDecimal is like NewDecimalFromString but panics on invalid input, for constants
+func MustDecimal(s string) Decimal {
+	d, err := NewDecimalFromString(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// NewDecimalFromFloat creates a Decimal from the shortest decimal representation of a float64 (0.1 is exactly 0.1)
+func NewDecimalFromFloat(value float64) (Decimal, error) {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return Decimal{}, fmt.Errorf("invalid decimal: %v", value)
+	}
+	return NewDecimalFromString(strconv.FormatFloat(value, 'f', -1, 64))
+}
+
+// Units returns the value as an integer amount of token units with the given decimals (1.5 with 6 decimals is 1500000)
+// Digits beyond decimals are truncated, round the value first when they matter
+func (d Decimal) Units(decimals int32) *big.Int {
+	return new(big.Int).Set(d.RoundDown(decimals).rescale(decimals).c())
+}
+
+// Add returns d + d2
+func (d Decimal) Add(d2 Decimal) Decimal {
+	a, b, scale := align(d, d2)
+	return Decimal{coef: new(big.Int).Add(a, b), scale: scale}
+}
+
+// Sub returns d - d2
+func (d Decimal) Sub(d2 Decimal) Decimal {
+	a, b, scale := align(d, d2)
+	return Decimal{coef: new(big.Int).Sub(a, b), scale: scale}
+}
+
+// Mul returns d * d2 (exact)
+func (d Decimal) Mul(d2 Decimal) Decimal {
+	return Decimal{coef: new(big.Int).Mul(d.c(), d2.c()), scale: d.scale + d2.scale}
+}
+
+// Quo returns d / d2 truncated toward zero to places decimal places, it panics if d2 is zero
+func (d Decimal) Quo(d2 Decimal, places int32) Decimal {
+	if d2.IsZero() {
+		panic("models: decimal division by zero")
+	}
+	if places < 0 {
+		places = 0
+	}
+	// d / d2 = (d.coef * 10^(places + d2.scale)) / (d2.coef * 10^d.scale) * 10^-places
+	numerator := new(big.Int).Mul(d.c(), pow10(places+d2.scale))
+	denominator := new(big.Int).Mul(d2.c(), pow10(d.scale))
+	return Decimal{coef: numerator.Quo(numerator, denominator), scale: places}
+}
+
+// Neg returns -d
+func (d Decimal) Neg() Decimal {
+	return Decimal{coef: new(big.Int).Neg(d.c()), scale: d.scale}
+}
+
+// Cmp compares d and d2 and returns -1, 0 or +1
+func (d Decimal) Cmp(d2 Decimal) int {
+	a, b, _ := align(d, d2)
+	return a.Cmp(b)
+}
+
+// Equal reports whether d and d2 have the same value (0.5 equals 0.50)
+func (d Decimal) Equal(d2 Decimal) bool {
+	return d.Cmp(d2) == 0
+}
+
+// Sign returns -1, 0 or +1 depending on the sign of d
+func (d Decimal) Sign() int {
+	return d.c().Sign()
+}
+
+// IsZero reports whether d is 0
+func (d Decimal) IsZero() bool {
+	return d.Sign() == 0
+}
+
+// Round rounds d to places decimal places, halves away from zero (clob-client roundNormal)
+func (d Decimal) Round(places int32) Decimal {
+	return d.round(places, roundHalfUp)
+}
+
+// RoundDown rounds d toward zero to places decimal places (clob-client roundDown)
+func (d Decimal) RoundDown(places int32) Decimal {
+	return d.round(places, roundDown)
+}
+
+// RoundUp rounds d away from zero to places decimal places (clob-client roundUp)
+func (d Decimal) RoundUp(places int32) Decimal {
+	return d.round(places, roundUp)
+}
+
+// DecimalPlaces returns the number of decimal places of d, trailing zeros excluded (0.50 has 1)
+func (d Decimal) DecimalPlaces() int32 {
+	return d.normalize().scale
+}
+
+// Float64 returns the nearest float64 value of d
+func (d Decimal) Float64() float64 {
+	f, _ := strconv.ParseFloat(d.String(), 64)
+	return f
+}
+
+// String returns the shortest decimal representation of d, without exponent or trailing zeros ("0.55", "12")
+func (d Decimal) String() string {
+	n := d.normalize()
+	digits := new(big.Int).Abs(n.c()).String()
+
+	var sb strings.Builder
+	if n.Sign() < 0 {
+		sb.WriteByte('-')
+	}
+	if n.scale == 0 {
+		sb.WriteString(digits)
+		return sb.String()
+	}
+	if pad := int(n.scale) + 1 - len(digits); pad > 0 {
+		digits = strings.Repeat("0", pad) + digits
+	}
+	split := len(digits) - int(n.scale)
+	sb.WriteString(digits[:split])
+	sb.WriteByte('.')
+	sb.WriteString(digits[split:])
+	return sb.String()
+}
+
+// MarshalJSON encodes d as a JSON string ("0.55"), like the CLOB API prices and sizes
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON decodes d from a JSON string or number, null leaves d unchanged
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	s := string(data)
+	if len(data) > 0 && data[0] == '"' {
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+	}
+	parsed, err := NewDecimalFromString(s)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler
+func (d Decimal) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler
+func (d *Decimal) UnmarshalText(text []byte) error {
+	parsed, err := NewDecimalFromString(string(text))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// roundingMode how digits beyond the requested places are rounded
+type roundingMode int
+
+const (
+	roundHalfUp roundingMode = iota // Halves away from zero
+	roundDown                       // Toward zero
+	roundUp                         // Away from zero
+)
+
+// round rounds d to places decimal places with a rounding mode
+func (d Decimal) round(places int32, mode roundingMode) Decimal {
+	if places < 0 {
+		places = 0
+	}
+	if d.scale <= places {
+		return d
+	}
+
+	divisor := pow10(d.scale - places)
+	quotient, remainder := new(big.Int).QuoRem(d.c(), divisor, new(big.Int))
+	if remainder.Sign() != 0 {
+		away := false
+		switch mode {
+		case roundHalfUp:
+			away = new(big.Int).Lsh(remainder.Abs(remainder), 1).Cmp(divisor) >= 0
+		case roundUp:
+			away = true
+		}
+		if away {
+			quotient.Add(quotient, big.NewInt(int64(d.Sign())))
+		}
+	}
+	return Decimal{coef: quotient, scale: places}
+}
+
+// c returns the coefficient, never nil
+func (d Decimal) c() *big.Int {
+	if d.coef == nil {
+		return new(big.Int)
+	}
+	return d.coef
+}
+
+// rescale returns d with a larger scale (more trailing zeros), smaller scales are returned unchanged
+func (d Decimal) rescale(scale int32) Decimal {
+	if scale <= d.scale {
+		return d
+	}
+	return Decimal{coef: new(big.Int).Mul(d.c(), pow10(scale-d.scale)), scale: scale}
+}
+
+// rescaleNonNegative turns a negative scale into trailing zeros of the coefficient
+func (d Decimal) rescaleNonNegative() Decimal {
+	if d.scale >= 0 {
+		return d
+	}
+	return Decimal{coef: new(big.Int).Mul(d.c(), pow10(-d.scale))}
+}
+
+// normalize removes the trailing zeros of the fractional part
+func (d Decimal) normalize() Decimal {
+	coef := new(big.Int).Set(d.c())
+	scale := d.scale
+	ten := big.NewInt(10)
+	remainder := new(big.Int)
+	for scale > 0 && coef.Sign() != 0 {
+		quotient, r := new(big.Int).QuoRem(coef, ten, remainder)
+		if r.Sign() != 0 {
+			break
+		}
+		coef = quotient
+		scale--
+	}
+	if coef.Sign() == 0 {
+		scale = 0
+	}
+	return Decimal{coef: coef, scale: scale}
+}
+
+// align returns the coefficients of a and b at their common scale
+func align(a, b Decimal) (*big.Int, *big.Int, int32) {
+	scale := max(a.scale, b.scale)
+	return a.rescale(scale).c(), b.rescale(scale).c(), scale
+}
+
+// pow10 returns 10^n
+func pow10(n int32) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
@@ -0,0 +1,213 @@
+package models
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+)
+
+func TestNewDecimalFromString(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"0.55", "0.55"},
+		{"0.550", "0.55"},
+		{"-12", "-12"},
+		{"+3.5", "3.5"},
+		{" 7 ", "7"},
+		{"1e-3", "0.001"},
+		{"1.5E2", "150"},
+		{".5", "0.5"},
+		{"5.", "5"},
+		{"0.000", "0"},
+		{"123456789012345678901234567890.000000000001", "123456789012345678901234567890.000000000001"},
+	}
+	for _, tt := range tests {
+		got, err := NewDecimalFromString(tt.input)
+		if err != nil {
+			t.Errorf("NewDecimalFromString(%q) error: %v", tt.input, err)
+			continue
+		}
+		if got.String() != tt.want {
+			t.Errorf("NewDecimalFromString(%q) = %s, want %s", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestNewDecimalFromStringInvalid(t *testing.T) {
+	for _, input := range []string{"", "-", ".", "abc", "1.2.3", "1e", "1ex", "0x10", "1,5"} {
+		if _, err := NewDecimalFromString(input); err == nil {
+			t.Errorf("NewDecimalFromString(%q) expected an error", input)
+		}
+	}
+}
+
+func TestNewDecimalFromFloat(t *testing.T) {
+	tests := []struct {
+		input float64
+		want  string
+	}{
+		{0.1, "0.1"},
+		{0.56, "0.56"},
+		{21.04, "21.04"},
+		{0.82, "0.82"},
+		{1e-7, "0.0000001"},
+		{-2.5, "-2.5"},
+	}
+	for _, tt := range tests {
+		got, err := NewDecimalFromFloat(tt.input)
+		if err != nil {
+			t.Errorf("NewDecimalFromFloat(%v) error: %v", tt.input, err)
+			continue
+		}
+		if got.String() != tt.want {
+			t.Errorf("NewDecimalFromFloat(%v) = %s, want %s", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestDecimalArithmetic(t *testing.T) {
+	tests := []struct {
+		name string
+		got  Decimal
+		want string
+	}{
+		{"add", MustDecimal("0.1").Add(MustDecimal("0.2")), "0.3"},
+		{"sub", MustDecimal("1").Sub(MustDecimal("0.001")), "0.999"},
+		{"sub negative", MustDecimal("0.5").Sub(MustDecimal("0.75")), "-0.25"},
+		// float64: 0.56 * 21.04 = 11.782399999999999
+		{"mul", MustDecimal("0.56").Mul(MustDecimal("21.04")), "11.7824"},
+		// float64: 0.82 * 101 = 82.82000000000001
+		{"mul integer", MustDecimal("0.82").Mul(MustDecimal("101")), "82.82"},
+		{"quo exact", MustDecimal("1").Quo(MustDecimal("4"), 6), "0.25"},
+		{"quo truncated", MustDecimal("2").Quo(MustDecimal("3"), 6), "0.666666"},
+		{"quo negative truncated", MustDecimal("-2").Quo(MustDecimal("3"), 2), "-0.66"},
+		{"quo zero places", MustDecimal("7.9").Quo(MustDecimal("2"), 0), "3"},
+		{"neg", MustDecimal("1.5").Neg(), "-1.5"},
+		{"zero value", Decimal{}.Add(MustDecimal("0.01")), "0.01"},
+	}
+	for _, tt := range tests {
+		if tt.got.String() != tt.want {
+			t.Errorf("%s = %s, want %s", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
+func TestDecimalQuoByZeroPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Quo by zero did not panic")
+		}
+	}()
+	MustDecimal("1").Quo(Decimal{}, 6)
+}
+
+func TestDecimalRounding(t *testing.T) {
+	tests := []struct {
+		input  string
+		places int32
+		round  string
+		down   string
+		up     string
+	}{
+		{"0.555", 2, "0.56", "0.55", "0.56"},
+		{"0.554", 2, "0.55", "0.55", "0.56"},
+		{"0.55", 2, "0.55", "0.55", "0.55"},
+		{"0.5", 4, "0.5", "0.5", "0.5"},
+		{"-0.555", 2, "-0.56", "-0.55", "-0.56"},
+		{"-0.554", 2, "-0.55", "-0.55", "-0.56"},
+		{"2.5", 0, "3", "2", "3"},
+		{"0.0001", 3, "0", "0", "0.001"},
+		{"11.78239999", 4, "11.7824", "11.7823", "11.7824"},
+	}
+	for _, tt := range tests {
+		d := MustDecimal(tt.input)
+		if got := d.Round(tt.places); got.String() != tt.round {
+			t.Errorf("%s.Round(%d) = %s, want %s", tt.input, tt.places, got, tt.round)
+		}
+		if got := d.RoundDown(tt.places); got.String() != tt.down {
+			t.Errorf("%s.RoundDown(%d) = %s, want %s", tt.input, tt.places, got, tt.down)
+		}
+		if got := d.RoundUp(tt.places); got.String() != tt.up {
+			t.Errorf("%s.RoundUp(%d) = %s, want %s", tt.input, tt.places, got, tt.up)
+		}
+	}
+}
+
+func TestDecimalCompare(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"0.5", "0.50", 0},
+		{"0.5", "0.49", 1},
+		{"0.49", "0.5", -1},
+		{"-1", "0", -1},
+		{"0", "0.000", 0},
+	}
+	for _, tt := range tests {
+		if got := MustDecimal(tt.a).Cmp(MustDecimal(tt.b)); got != tt.want {
+			t.Errorf("Cmp(%s, %s) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+	if !MustDecimal("0.5").Equal(MustDecimal("0.50")) {
+		t.Error("0.5 should equal 0.50")
+	}
+	if !(Decimal{}).IsZero() || MustDecimal("0.001").IsZero() {
+		t.Error("IsZero mismatch")
+	}
+	if got := MustDecimal("0.500").DecimalPlaces(); got != 1 {
+		t.Errorf("DecimalPlaces(0.500) = %d, want 1", got)
+	}
+}
+
+func TestDecimalUnits(t *testing.T) {
+	tests := []struct {
+		input    string
+		decimals int32
+		want     string
+	}{
+		{"1.5", CollateralDecimals, "1500000"},
+		{"11.7824", CollateralDecimals, "11782400"},
+		{"0.0000019", CollateralDecimals, "1"},
+		{"0", CollateralDecimals, "0"},
+		{"12", 0, "12"},
+	}
+	for _, tt := range tests {
+		if got := MustDecimal(tt.input).Units(tt.decimals); got.String() != tt.want {
+			t.Errorf("%s.Units(%d) = %s, want %s", tt.input, tt.decimals, got, tt.want)
+		}
+	}
+
+	units, _ := new(big.Int).SetString("1500000", 10)
+	if got := NewDecimalFromUnits(units, CollateralDecimals); got.String() != "1.5" {
+		t.Errorf("NewDecimalFromUnits(1500000, 6) = %s, want 1.5", got)
+	}
+}
+
+func TestDecimalJSON(t *testing.T) {
+	data, err := json.Marshal(MustDecimal("0.550"))
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	if string(data) != `"0.55"` {
+		t.Errorf("marshal = %s, want \"0.55\"", data)
+	}
+
+	var v struct {
+		String Decimal `json:"string"`
+		Number Decimal `json:"number"`
+		Null   Decimal `json:"null"`
+	}
+	if err := json.Unmarshal([]byte(`{"string":"0.56","number":21.04,"null":null}`), &v); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if v.String.String() != "0.56" || v.Number.String() != "21.04" || !v.Null.IsZero() {
+		t.Errorf("unmarshal = %s %s %s", v.String, v.Number, v.Null)
+	}
+
+	if err := json.Unmarshal([]byte(`"abc"`), new(Decimal)); err == nil {
+		t.Error("unmarshal of an invalid decimal expected an error")
+	}
+}
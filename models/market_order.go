@@ -0,0 +1,172 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// ErrInsufficientLiquidity is returned when the orderbook cannot fill a market order
+var ErrInsufficientLiquidity = errors.New("insufficient orderbook liquidity")
+
+// CreateMarketOrderParams parameters for creating and posting a market order
+// Reference: https://github.com/Polymarket/clob-client
+type CreateMarketOrderParams struct {
+	TokenID string  // ERC1155 token ID (conditional token)
+	Side    int     // Order side: 0=BUY, 1=SELL
+	Amount  Decimal // USDC to spend for a buy, shares to sell for a sell
+	Price   Decimal // Worst acceptable price, zero computes it from the current orderbook
+}
+
+// MarketOrderQuote expected execution of a market order against an orderbook snapshot
+type MarketOrderQuote struct {
+	Price        Decimal // Worst price reached, the limit price of the order
+	AveragePrice Decimal // Expected average fill price
+	Shares       Decimal // Expected shares bought or sold
+	Notional     Decimal // Expected USDC spent (buy) or received (sell)
+	FullyFilled  bool    // Whether the orderbook holds enough liquidity for the whole amount
+}
+
+// CreateMarketOrderResponse response of a market order with the quote it was built from
+type CreateMarketOrderResponse struct {
+	*CreateOrderResponse
+	Quote *MarketOrderQuote // Expected execution computed before submission
+}
+
+// one upper bound (exclusive) of outcome prices
+var one = NewDecimalFromInt(1)
+
+// priceLevel parsed orderbook level
+type priceLevel struct {
+	price Decimal
+	size  Decimal
+}
+
+// QuoteMarketOrder walks the orderbook from the best level like clob-client calculateMarketPrice:
+// the asks for a buy of amount USDC, the bids for a sell of amount shares.
+// Levels priced worse than limit are ignored unless limit is zero.
+func QuoteMarketOrder(book *OrderBookSummary, side OrderSide, amount, limit Decimal) (*MarketOrderQuote, error) {
+	if book == nil {
+		return nil, fmt.Errorf("order book is required")
+	}
+	if amount.Sign() <= 0 {
+		return nil, fmt.Errorf("market order amount must be positive: %s", amount)
+	}
+
+	summaries := book.Asks
+	if side == OrderSideSell {
+		summaries = book.Bids
+	}
+	levels, err := bestLevelsFirst(summaries, side)
+	if err != nil {
+		return nil, err
+	}
+
+	quote := &MarketOrderQuote{}
+	remaining := amount
+	for _, level := range levels {
+		if !limit.IsZero() && worse(level.price, limit, side) {
+			break
+		}
+		quote.Price = level.price
+
+		if side == OrderSideBuy {
+			value := level.size.Mul(level.price)
+			if remaining.Cmp(value) <= 0 {
+				quote.Shares = quote.Shares.Add(remaining.Quo(level.price, CollateralDecimals))
+				quote.Notional = quote.Notional.Add(remaining)
+				quote.FullyFilled = true
+				break
+			}
+			quote.Shares = quote.Shares.Add(level.size)
+			quote.Notional = quote.Notional.Add(value)
+			remaining = remaining.Sub(value)
+		} else {
+			if remaining.Cmp(level.size) <= 0 {
+				quote.Shares = quote.Shares.Add(remaining)
+				quote.Notional = quote.Notional.Add(remaining.Mul(level.price))
+				quote.FullyFilled = true
+				break
+			}
+			quote.Shares = quote.Shares.Add(level.size)
+			quote.Notional = quote.Notional.Add(level.size.Mul(level.price))
+			remaining = remaining.Sub(level.size)
+		}
+	}
+
+	if quote.Shares.IsZero() {
+		return nil, fmt.Errorf("quote market order: %w", ErrInsufficientLiquidity)
+	}
+	if !limit.IsZero() {
+		quote.Price = limit
+	}
+	quote.AveragePrice = quote.Notional.Quo(quote.Shares, CollateralDecimals)
+	return quote, nil
+}
+
+// MarketOrderAmounts computes the amounts of a market order like clob-client getMarketOrderRawAmounts:
+// the price is rounded down to the tick size and the amount down to the size decimals, a buy gives
+// amount USDC for amount/price shares and a sell gives amount shares for amount*price USDC
+// The rounded price must be within (0, 1) and the rounded amount positive, other orders can never be valid
+func MarketOrderAmounts(side OrderSide, amount, price Decimal, config RoundingConfig) (*OrderAmounts, error) {
+	roundedPrice := price.RoundDown(config.Price)
+	if roundedPrice.Sign() <= 0 || roundedPrice.Cmp(one) >= 0 {
+		return nil, fmt.Errorf("market order price %s (%s rounded) must be between 0 and 1", price, roundedPrice)
+	}
+	rounded := amount.RoundDown(config.Size)
+	if rounded.Sign() <= 0 {
+		return nil, fmt.Errorf("market order amount %s (%s rounded) must be positive", amount, rounded)
+	}
+
+	amounts := &OrderAmounts{Price: roundedPrice}
+	if side == OrderSideBuy {
+		// Round the quotient up at Amount+4 decimals, then down to Amount decimals
+		shares := rounded.Quo(roundedPrice, config.Amount+4)
+		if !shares.Mul(roundedPrice).Equal(rounded) {
+			shares = shares.Add(NewDecimal(1, config.Amount+4))
+		}
+		shares = shares.RoundDown(config.Amount)
+
+		amounts.Size = shares
+		amounts.MakerAmount = rounded.Units(CollateralDecimals)
+		amounts.TakerAmount = shares.Units(CollateralDecimals)
+	} else {
+		amounts.Size = rounded
+		amounts.MakerAmount = rounded.Units(CollateralDecimals)
+		amounts.TakerAmount = roundAmount(rounded.Mul(roundedPrice), config).Units(CollateralDecimals)
+	}
+	return amounts, nil
+}
+
+// bestLevelsFirst parses orderbook levels and sorts them from the best price for a taker of side
+// Empty levels and levels priced outside (0, 1), which no order can match, are skipped
+func bestLevelsFirst(summaries []OrderSummary, side OrderSide) ([]priceLevel, error) {
+	levels := make([]priceLevel, 0, len(summaries))
+	for _, summary := range summaries {
+		price, err := NewDecimalFromString(summary.Price)
+		if err != nil {
+			return nil, fmt.Errorf("order book price: %w", err)
+		}
+		size, err := NewDecimalFromString(summary.Size)
+		if err != nil {
+			return nil, fmt.Errorf("order book size: %w", err)
+		}
+		if size.Sign() <= 0 || price.Sign() <= 0 || price.Cmp(one) >= 0 {
+			continue
+		}
+		levels = append(levels, priceLevel{price: price, size: size})
+	}
+
+	sort.SliceStable(levels, func(i, j int) bool {
+		return worse(levels[j].price, levels[i].price, side)
+	})
+	return levels, nil
+}
+
+// worse reports whether price is worse than other for a taker of side (higher for a buy, lower for a sell)
+func worse(price, other Decimal, side OrderSide) bool {
+	if side == OrderSideBuy {
+		return price.Cmp(other) > 0
+	}
+	return price.Cmp(other) < 0
+}
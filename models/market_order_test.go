@@ -0,0 +1,96 @@
+package models
+
+import (
+	"errors"
+	"testing"
+)
+
+// testBook orderbook with unsorted levels, asks worth 5 + 10.4 + 55 = 70.4 USDC and 30 bid shares
+func testBook() *OrderBookSummary {
+	return &OrderBookSummary{
+		Asks: []OrderSummary{{Price: "0.55", Size: "100"}, {Price: "0.5", Size: "10"}, {Price: "0.52", Size: "20"}},
+		Bids: []OrderSummary{{Price: "0.45", Size: "20"}, {Price: "0.48", Size: "10"}},
+	}
+}
+
+func TestQuoteMarketOrder(t *testing.T) {
+	tests := []struct {
+		name         string
+		book         *OrderBookSummary
+		side         OrderSide
+		amount       string
+		limit        string
+		wantPrice    string
+		wantAverage  string
+		wantShares   string
+		wantNotional string
+		wantFilled   bool
+	}{
+		{"buy within best level", testBook(), OrderSideBuy, "5", "0", "0.5", "0.5", "10", "5", true},
+		{"buy across levels", testBook(), OrderSideBuy, "10", "0", "0.52", "0.509803", "19.615384", "10", true},
+		{"buy insufficient depth", testBook(), OrderSideBuy, "100", "0", "0.55", "0.541538", "130", "70.4", false},
+		{"buy limit cutoff", testBook(), OrderSideBuy, "100", "0.52", "0.52", "0.513333", "30", "15.4", false},
+		{"sell across levels", testBook(), OrderSideSell, "15", "0", "0.45", "0.47", "15", "7.05", true},
+		{"sell insufficient depth", testBook(), OrderSideSell, "40", "0", "0.45", "0.46", "30", "13.8", false},
+		{"sell limit cutoff", testBook(), OrderSideSell, "40", "0.46", "0.46", "0.48", "10", "4.8", false},
+		{
+			"buy skips zero and one prices",
+			&OrderBookSummary{Asks: []OrderSummary{{Price: "0", Size: "100"}, {Price: "1", Size: "50"}, {Price: "0.5", Size: "10"}}},
+			OrderSideBuy, "5", "0", "0.5", "0.5", "10", "5", true,
+		},
+		{
+			"sell skips zero price and empty levels",
+			&OrderBookSummary{Bids: []OrderSummary{{Price: "0", Size: "100"}, {Price: "0.6", Size: "0"}, {Price: "0.48", Size: "10"}}},
+			OrderSideSell, "20", "0", "0.48", "0.48", "10", "4.8", false,
+		},
+	}
+	for _, tt := range tests {
+		quote, err := QuoteMarketOrder(tt.book, tt.side, MustDecimal(tt.amount), MustDecimal(tt.limit))
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if quote.Price.String() != tt.wantPrice || quote.AveragePrice.String() != tt.wantAverage {
+			t.Errorf("%s: price, average = %s, %s, want %s, %s", tt.name, quote.Price, quote.AveragePrice, tt.wantPrice, tt.wantAverage)
+		}
+		if quote.Shares.String() != tt.wantShares || quote.Notional.String() != tt.wantNotional {
+			t.Errorf("%s: shares, notional = %s, %s, want %s, %s", tt.name, quote.Shares, quote.Notional, tt.wantShares, tt.wantNotional)
+		}
+		if quote.FullyFilled != tt.wantFilled {
+			t.Errorf("%s: fully filled = %v, want %v", tt.name, quote.FullyFilled, tt.wantFilled)
+		}
+	}
+}
+
+func TestQuoteMarketOrderNoLiquidity(t *testing.T) {
+	tests := []struct {
+		name  string
+		book  *OrderBookSummary
+		side  OrderSide
+		limit string
+	}{
+		{"empty asks", &OrderBookSummary{Bids: testBook().Bids}, OrderSideBuy, "0"},
+		{"buy limit below best ask", testBook(), OrderSideBuy, "0.49"},
+		{"sell limit above best bid", testBook(), OrderSideSell, "0.49"},
+		{"only a zero price level", &OrderBookSummary{Asks: []OrderSummary{{Price: "0", Size: "100"}}}, OrderSideBuy, "0"},
+	}
+	for _, tt := range tests {
+		_, err := QuoteMarketOrder(tt.book, tt.side, MustDecimal("10"), MustDecimal(tt.limit))
+		if !errors.Is(err, ErrInsufficientLiquidity) {
+			t.Errorf("%s: error = %v, want ErrInsufficientLiquidity", tt.name, err)
+		}
+	}
+}
+
+func TestQuoteMarketOrderInvalid(t *testing.T) {
+	if _, err := QuoteMarketOrder(nil, OrderSideBuy, MustDecimal("10"), Decimal{}); err == nil {
+		t.Error("nil book expected an error")
+	}
+	if _, err := QuoteMarketOrder(testBook(), OrderSideBuy, Decimal{}, Decimal{}); err == nil {
+		t.Error("zero amount expected an error")
+	}
+	book := &OrderBookSummary{Asks: []OrderSummary{{Price: "abc", Size: "10"}}}
+	if _, err := QuoteMarketOrder(book, OrderSideBuy, MustDecimal("10"), Decimal{}); err == nil {
+		t.Error("invalid price level expected an error")
+	}
+}
@@ -1,6 +1,9 @@
 package models
 
-import "time"
+import (
+	"math/big"
+	"time"
+)
 
 // OrderType order type enumeration
 type OrderType string
@@ -39,29 +42,32 @@ const (
 )
 
 // SignedOrder signed order object (according to Polymarket CLOB API documentation)
+// The uint256 fields are carried losslessly: the salt as a big.Int encoded as a JSON number like the CLOB
+// expects, the other ones as decimal strings, so the posted order hashes to the signed hash.
 // Reference: https://docs.polymarket.com/developers/CLOB/orders/create-order
 type SignedOrder struct {
-	Salt          int64  `json:"salt"`          // Random salt value for creating unique orders
-	Maker         string `json:"maker"`         // Maker address (funder)
-	Signer        string `json:"signer"`        // Signer address
-	Taker         string `json:"taker"`         // Taker address (operator)
-	TokenID       string `json:"tokenId"`       // ERC1155 token ID (conditional token)
-	MakerAmount   string `json:"makerAmount"`   // Maximum amount maker is willing to pay
-	TakerAmount   string `json:"takerAmount"`   // Minimum amount taker will pay to maker
-	Expiration    string `json:"expiration"`    // Unix expiration timestamp
-	Nonce         string `json:"nonce"`         // Maker's exchange nonce
-	FeeRateBps    string `json:"feeRateBps"`    // Fee rate in basis points, required by operator
-	Side          string `json:"side"`          // Buy or sell enumeration index
-	SignatureType int    `json:"signatureType"` // Signature type enumeration index
-	Signature     string `json:"signature"`     // Hex-encoded signature
+	Salt          *big.Int `json:"salt"`          // Random salt value for creating unique orders
+	Maker         string   `json:"maker"`         // Maker address (funder)
+	Signer        string   `json:"signer"`        // Signer address
+	Taker         string   `json:"taker"`         // Taker address (operator)
+	TokenID       string   `json:"tokenId"`       // ERC1155 token ID (conditional token)
+	MakerAmount   string   `json:"makerAmount"`   // Maximum amount maker is willing to pay
+	TakerAmount   string   `json:"takerAmount"`   // Minimum amount taker will pay to maker
+	Expiration    string   `json:"expiration"`    // Unix expiration timestamp
+	Nonce         string   `json:"nonce"`         // Maker's exchange nonce
+	FeeRateBps    string   `json:"feeRateBps"`    // Fee rate in basis points, required by operator
+	Side          string   `json:"side"`          // Buy or sell enumeration index
+	SignatureType int      `json:"signatureType"` // Signature type enumeration index
+	Signature     string   `json:"signature"`     // Hex-encoded signature
 }
 
 // CreateOrderRequest create order request (according to Polymarket CLOB API documentation)
 // Reference: https://docs.polymarket.com/developers/CLOB/orders/create-order
 type CreateOrderRequest struct {
-	Order     *SignedOrder `json:"order"`     // Signed order object
-	Owner     string       `json:"owner"`     // API key of the order owner
-	OrderType OrderType    `json:"orderType"` // Order type ("FOK", "GTC", "GTD", "FAK")
+	Order     *SignedOrder `json:"order"`              // Signed order object
+	Owner     string       `json:"owner"`              // API key of the order owner
+	OrderType OrderType    `json:"orderType"`          // Order type ("FOK", "GTC", "GTD", "FAK")
+	PostOnly  bool         `json:"postOnly,omitempty"` // Reject the order instead of matching on arrival (GTC and GTD only)
 }
 
 // CreateOrderResponse create order response (according to Polymarket CLOB API documentation)
@@ -73,6 +79,20 @@ type CreateOrderResponse struct {
 	Status      string   `json:"status,omitempty"` // Order status: "matched", "live", "delayed", "unmatched"
 }
 
+// PostOrderArgs order of a batch posted with OrdersAPI.PostOrders
+// Reference: https://docs.polymarket.com/developers/CLOB/orders/create-order-batch
+type PostOrderArgs struct {
+	Order     *SignedOrder // Signed order object
+	OrderType OrderType    // Order type ("FOK", "GTC", "GTD", "FAK")
+	PostOnly  bool         // Reject the order instead of matching on arrival (GTC and GTD only)
+}
+
+// PostOrderResult result of an order of a batch, results are aligned to the posted orders
+type PostOrderResult struct {
+	*CreateOrderResponse       // Response of the CLOB for this order, nil when its chunk request failed
+	Err                  error // Rejection of the order or failure of its chunk request, nil on success
+}
+
 // OrderStatus order status
 type OrderStatus string
 
@@ -158,9 +178,9 @@ type OrdersScoringResponse map[string]bool
 // Reference: https://github.com/Polymarket/clob-client
 type CreateAndPostOrderParams struct {
 	TokenID string  // ERC1155 token ID (conditional token)
-	Price   float64 // Order price
+	Price   Decimal // Order price, within (0, 1) and aligned to the tick size
 	Side    int     // Order side: 0=BUY, 1=SELL
-	Size    float64 // Order size
+	Size    Decimal // Order size in shares, rounded down to 2 decimals
 }
 
 // CreateAndPostOrderConfig configuration for creating and posting an order
@@ -168,4 +188,11 @@ type CreateAndPostOrderParams struct {
 type CreateAndPostOrderConfig struct {
 	TickSize string // Price precision (e.g., "0.001"), if empty will be fetched from API automatically
 	NegRisk  *bool  // Whether to use negative risk contract, if nil will be fetched from API automatically
+	// Expiration of a GTD order (required for GTD, rejected for other order types), must be more than
+	// a minute after the server time and not after the market end date
+	Expiration time.Time
+	// Post-only (maker-only): the order is rejected instead of matching on arrival, GTC and GTD only
+	PostOnly bool
+	// Maker's exchange nonce, if nil read by the orders API nonce manager (api.NonceManager) or 0 without one
+	Nonce *big.Int
 }
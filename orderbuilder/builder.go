@@ -0,0 +1,240 @@
+// Package orderbuilder builds and signs CLOB orders offline
+//
+// All market parameters (tick size, neg-risk, fee rate) and order parameters (nonce, expiration, funder,
+// signature type) are explicit and nothing is fetched, so orders can be pre-signed in an isolated signing
+// service and submitted later with OrdersAPI.CreateOrder or OrdersAPI.PostOrders. VerifyOrder checks signed
+// orders received from other services before they are forwarded.
+package orderbuilder
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/mtt-labs/poly-market-sdk/auth"
+	"github.com/mtt-labs/poly-market-sdk/models"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/polymarket/go-order-utils/pkg/builder"
+	orderconfig "github.com/polymarket/go-order-utils/pkg/config"
+	ordermodel "github.com/polymarket/go-order-utils/pkg/model"
+)
+
+// MarketParams parameters of the market of a token
+// OrdersAPI.GetTickSize, GetNegRisk and GetFeeRateBps return them when building online
+type MarketParams struct {
+	TickSize   string // Minimum tick size ("0.1", "0.01", "0.001" or "0.0001")
+	NegRisk    bool   // Whether the market is a neg-risk market (NegRisk CTF Exchange)
+	FeeRateBps int    // Fee rate in basis points required by the operator
+}
+
+// OrderArgs common parameters of an order
+type OrderArgs struct {
+	TokenID    string           // ERC1155 token ID (conditional token)
+	Side       models.OrderSide // Order side
+	Market     MarketParams     // Market parameters of the token
+	Nonce      *big.Int         // Maker's exchange nonce, nil is 0
+	Expiration int64            // Unix expiration timestamp for GTD orders, 0 for other order types
+}
+
+// LimitOrderArgs parameters of a limit order
+type LimitOrderArgs struct {
+	OrderArgs
+	Price models.Decimal // Price, rounded to the tick size
+	Size  models.Decimal // Size in shares, rounded down to the size decimals
+}
+
+// MarketOrderArgs parameters of a market order (FOK or FAK)
+// Offline there is no orderbook to walk, so the worst acceptable price is required
+// (OrdersAPI.QuoteMarketOrder computes it from the current orderbook)
+type MarketOrderArgs struct {
+	OrderArgs
+	Amount models.Decimal // USDC to spend for a buy, shares to sell for a sell
+	Price  models.Decimal // Worst acceptable price
+}
+
+// Order signed order with its EIP-712 hash
+type Order struct {
+	SignedOrder *models.SignedOrder  // Signed order, as posted to the CLOB
+	Hash        common.Hash          // EIP-712 hash of the order, the one that was signed
+	Amounts     *models.OrderAmounts // Rounded price, size and amounts of the order
+	NegRisk     bool                 // Whether the order was signed for the NegRisk CTF Exchange
+}
+
+// Builder builds and signs orders of a maker without any network I/O
+type Builder struct {
+	chainID       int
+	contracts     *orderconfig.Contracts // Exchanges of the EIP-712 domains
+	signer        auth.Signer
+	funder        common.Address
+	signatureType models.SignatureType
+	saltGenerator func() int64 // Order salts, nil uses random salts
+}
+
+// NewBuilder creates a Builder signing orders with signer on a chain (137 Polygon, 80002 Amoy)
+// funder is the order maker: the signer address for SignatureTypeEOA (empty funder), the proxy wallet
+// or Gnosis Safe of the signer otherwise (see auth.ProxyWalletAddress and auth.SafeAddress)
+func NewBuilder(chainID int, signer auth.Signer, signatureType models.SignatureType, funder string) (*Builder, error) {
+	contracts, err := chainContracts(chainID)
+	if err != nil {
+		return nil, err
+	}
+	return NewBuilderWithContracts(chainID, contracts, signer, signatureType, funder)
+}
+
+// NewBuilderWithContracts is like NewBuilder but signs orders for the exchanges of contracts
+// (client.Network().Contracts), e.g. on a custom network
+func NewBuilderWithContracts(chainID int, contracts *orderconfig.Contracts, signer auth.Signer, signatureType models.SignatureType, funder string) (*Builder, error) {
+	if contracts == nil {
+		return nil, fmt.Errorf("contracts are required")
+	}
+	if signer == nil {
+		return nil, fmt.Errorf("signer is required")
+	}
+	switch signatureType {
+	case models.SignatureTypeEOA, models.SignatureTypeEmailMagic, models.SignatureTypeBrowserWallet:
+	default:
+		return nil, fmt.Errorf("unsupported signature type: %d", signatureType)
+	}
+
+	maker := common.HexToAddress(signer.Address())
+	if funder != "" {
+		if !common.IsHexAddress(funder) {
+			return nil, fmt.Errorf("invalid funder address: %s", funder)
+		}
+		maker = common.HexToAddress(funder)
+	} else if signatureType != models.SignatureTypeEOA {
+		return nil, fmt.Errorf("funder is required for signature type %d", signatureType)
+	}
+
+	return &Builder{
+		chainID:       chainID,
+		contracts:     contracts,
+		signer:        signer,
+		funder:        maker,
+		signatureType: signatureType,
+	}, nil
+}
+
+// Funder returns the maker address of the built orders
+func (b *Builder) Funder() string {
+	return b.funder.Hex()
+}
+
+// BuildLimitOrder rounds a limit order to the tick size of the market and signs it
+func (b *Builder) BuildLimitOrder(args *LimitOrderArgs) (*Order, error) {
+	if args == nil {
+		return nil, fmt.Errorf("order args are required")
+	}
+	config, err := models.RoundingConfigForTickSize(args.Market.TickSize)
+	if err != nil {
+		return nil, err
+	}
+
+	// Out of range inputs would round to zero or meaningless amounts that still get signed
+	if price := args.Price.Round(config.Price); price.Sign() <= 0 || price.Cmp(models.NewDecimalFromInt(1)) >= 0 {
+		return nil, fmt.Errorf("limit order price %s (%s at tick size %s) must be between 0 and 1", args.Price, price, args.Market.TickSize)
+	}
+	if size := args.Size.RoundDown(config.Size); size.Sign() <= 0 {
+		return nil, fmt.Errorf("limit order size %s (%s rounded) must be positive", args.Size, size)
+	}
+
+	amounts := models.LimitOrderAmounts(args.Side, args.Price, args.Size, config)
+	return b.Sign(&args.OrderArgs, amounts)
+}
+
+// BuildMarketOrder rounds a market order to the tick size of the market and signs it
+func (b *Builder) BuildMarketOrder(args *MarketOrderArgs) (*Order, error) {
+	if args == nil {
+		return nil, fmt.Errorf("order args are required")
+	}
+	if args.Expiration != 0 {
+		return nil, fmt.Errorf("market orders cannot have an expiration")
+	}
+	config, err := models.RoundingConfigForTickSize(args.Market.TickSize)
+	if err != nil {
+		return nil, err
+	}
+	amounts, err := models.MarketOrderAmounts(args.Side, args.Amount, args.Price, config)
+	if err != nil {
+		return nil, err
+	}
+	return b.Sign(&args.OrderArgs, amounts)
+}
+
+// Sign builds and signs an order with already rounded amounts, args.Market.TickSize is not used
+func (b *Builder) Sign(args *OrderArgs, amounts *models.OrderAmounts) (*Order, error) {
+	return b.SignCtx(context.Background(), args, amounts)
+}
+
+// SignCtx is like Sign but bound to ctx, a signer implementing auth.ContextSigner gives up when ctx is done
+func (b *Builder) SignCtx(ctx context.Context, args *OrderArgs, amounts *models.OrderAmounts) (*Order, error) {
+	if args == nil || amounts == nil {
+		return nil, fmt.Errorf("order args and amounts are required")
+	}
+	if args.Side != models.OrderSideBuy && args.Side != models.OrderSideSell {
+		return nil, fmt.Errorf("invalid order side: %d", args.Side)
+	}
+	if args.Market.FeeRateBps < 0 {
+		return nil, fmt.Errorf("invalid fee rate: %d bps", args.Market.FeeRateBps)
+	}
+	if args.Expiration < 0 {
+		return nil, fmt.Errorf("invalid expiration: %d", args.Expiration)
+	}
+	nonce := args.Nonce
+	if nonce == nil {
+		nonce = big.NewInt(0)
+	}
+
+	orderData := &ordermodel.OrderData{
+		Maker:         b.funder.Hex(),
+		Taker:         common.Address{}.Hex(), // Public order
+		TokenId:       args.TokenID,
+		MakerAmount:   amounts.MakerAmount.String(),
+		TakerAmount:   amounts.TakerAmount.String(),
+		FeeRateBps:    strconv.Itoa(args.Market.FeeRateBps),
+		Nonce:         nonce.String(),
+		Expiration:    strconv.FormatInt(args.Expiration, 10),
+		Side:          ordermodel.Side(args.Side),
+		SignatureType: ordermodel.SignatureType(b.signatureType),
+		Signer:        b.signer.Address(),
+	}
+
+	// Use go-order-utils to build the order, its hash for the exchange is signed by the signer
+	orderBuilder := builder.NewExchangeOrderBuilderImpl(big.NewInt(int64(b.chainID)), b.saltGenerator)
+	order, err := orderBuilder.BuildOrder(orderData)
+	if err != nil {
+		return nil, fmt.Errorf("build order: %w", err)
+	}
+	orderHash, err := orderHash(b.chainID, b.contracts, order, args.Market.NegRisk)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := auth.SignOrderHashCtx(ctx, b.signer, orderHash)
+	if err != nil {
+		return nil, fmt.Errorf("sign order: %w", err)
+	}
+
+	return &Order{
+		SignedOrder: &models.SignedOrder{
+			Salt:          order.Salt,
+			Maker:         order.Maker.Hex(),
+			Signer:        order.Signer.Hex(),
+			Taker:         order.Taker.Hex(),
+			TokenID:       order.TokenId.String(),
+			MakerAmount:   order.MakerAmount.String(),
+			TakerAmount:   order.TakerAmount.String(),
+			Expiration:    order.Expiration.String(),
+			Nonce:         order.Nonce.String(),
+			FeeRateBps:    order.FeeRateBps.String(),
+			Side:          order.Side.String(),
+			SignatureType: int(b.signatureType),
+			Signature:     "0x" + hex.EncodeToString(signature),
+		},
+		Hash:    orderHash,
+		Amounts: amounts,
+		NegRisk: args.Market.NegRisk,
+	}, nil
+}
@@ -0,0 +1,315 @@
+package orderbuilder
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/mtt-labs/poly-market-sdk/auth"
+	"github.com/mtt-labs/poly-market-sdk/models"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	orderconfig "github.com/polymarket/go-order-utils/pkg/config"
+)
+
+// testPrivateKey well-known development key (address 0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266)
+const testPrivateKey = "ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80"
+
+const (
+	testTokenID = "71321045679252212594626385532706912750332728571942532289631379312455583992563"
+	testSalt    = 479249096354
+)
+
+// newTestBuilder creates an EOA builder on Polygon with a fixed salt
+func newTestBuilder(t *testing.T) *Builder {
+	t.Helper()
+	signer, err := auth.NewPrivateKeySigner(testPrivateKey)
+	if err != nil {
+		t.Fatalf("NewPrivateKeySigner: %v", err)
+	}
+	b, err := NewBuilder(auth.PolygonChainID, signer, models.SignatureTypeEOA, "")
+	if err != nil {
+		t.Fatalf("NewBuilder: %v", err)
+	}
+	b.saltGenerator = func() int64 { return testSalt }
+	return b
+}
+
+func limitArgs(side models.OrderSide, price, size string, negRisk bool) *LimitOrderArgs {
+	return &LimitOrderArgs{
+		OrderArgs: OrderArgs{
+			TokenID: testTokenID,
+			Side:    side,
+			Market:  MarketParams{TickSize: "0.01", NegRisk: negRisk},
+		},
+		Price: models.MustDecimal(price),
+		Size:  models.MustDecimal(size),
+	}
+}
+
+func TestBuildLimitOrderDeterministicSignature(t *testing.T) {
+	tests := []struct {
+		negRisk   bool
+		hash      string
+		signature string
+	}{
+		{
+			negRisk:   false,
+			hash:      "0xb4b76f31806813e91434f259c92a95112a53cac36ba01bcc125deec32774fc0d",
+			signature: "0xf62aa47683c432e0f231c87f3a70503f31ff15921c3bdba625fc2805b4da88a37d064aeb44cbf40df84c2708437ae07f8ad19e8718873a14f33a4501237fe7aa1b",
+		},
+		{
+			negRisk:   true,
+			hash:      "0x976bb141c65a4caba3ceafa1a5b98e10b4d0f549c2418fc4acbe98a009e43f95",
+			signature: "0xa7b14adddcae23a1e5280c89fc20b73122e754b8503d5db60548c1f811ad58db6451e693fc1fad6e56e8b27977ccb352be46a11bd27fdd24b7f0d55b0168efe61c",
+		},
+	}
+	b := newTestBuilder(t)
+	for _, tt := range tests {
+		order, err := b.BuildLimitOrder(limitArgs(models.OrderSideBuy, "0.56", "21.04", tt.negRisk))
+		if err != nil {
+			t.Fatalf("BuildLimitOrder: %v", err)
+		}
+		if order.Hash.Hex() != tt.hash {
+			t.Errorf("neg risk %v: hash = %s, want %s", tt.negRisk, order.Hash.Hex(), tt.hash)
+		}
+		if order.SignedOrder.Signature != tt.signature {
+			t.Errorf("neg risk %v: signature = %s, want %s", tt.negRisk, order.SignedOrder.Signature, tt.signature)
+		}
+		if hash := typedDataHash(t, order.SignedOrder, tt.negRisk); hash != order.Hash {
+			t.Errorf("neg risk %v: EIP-712 typed data hash = %s, want %s", tt.negRisk, hash.Hex(), order.Hash.Hex())
+		}
+		if order.SignedOrder.Salt.Int64() != testSalt || order.SignedOrder.Signer != b.Funder() {
+			t.Errorf("neg risk %v: salt %s, signer %s", tt.negRisk, order.SignedOrder.Salt, order.SignedOrder.Signer)
+		}
+	}
+}
+
+// typedDataHash hashes a signed order with the generic EIP-712 encoder of go-ethereum
+func typedDataHash(t *testing.T, order *models.SignedOrder, negRisk bool) common.Hash {
+	t.Helper()
+	contracts, err := orderconfig.GetContracts(auth.PolygonChainID)
+	if err != nil {
+		t.Fatalf("GetContracts: %v", err)
+	}
+	exchange := contracts.Exchange
+	if negRisk {
+		exchange = contracts.NegRiskExchange
+	}
+
+	typedData := apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+				{Name: "verifyingContract", Type: "address"},
+			},
+			"Order": {
+				{Name: "salt", Type: "uint256"},
+				{Name: "maker", Type: "address"},
+				{Name: "signer", Type: "address"},
+				{Name: "taker", Type: "address"},
+				{Name: "tokenId", Type: "uint256"},
+				{Name: "makerAmount", Type: "uint256"},
+				{Name: "takerAmount", Type: "uint256"},
+				{Name: "expiration", Type: "uint256"},
+				{Name: "nonce", Type: "uint256"},
+				{Name: "feeRateBps", Type: "uint256"},
+				{Name: "side", Type: "uint8"},
+				{Name: "signatureType", Type: "uint8"},
+			},
+		},
+		PrimaryType: "Order",
+		Domain: apitypes.TypedDataDomain{
+			Name:              "Polymarket CTF Exchange",
+			Version:           "1",
+			ChainId:           math.NewHexOrDecimal256(auth.PolygonChainID),
+			VerifyingContract: exchange.Hex(),
+		},
+		Message: apitypes.TypedDataMessage{
+			"salt":          order.Salt.String(),
+			"maker":         order.Maker,
+			"signer":        order.Signer,
+			"taker":         order.Taker,
+			"tokenId":       order.TokenID,
+			"makerAmount":   order.MakerAmount,
+			"takerAmount":   order.TakerAmount,
+			"expiration":    order.Expiration,
+			"nonce":         order.Nonce,
+			"feeRateBps":    order.FeeRateBps,
+			"side":          order.Side,
+			"signatureType": big.NewInt(int64(order.SignatureType)).String(),
+		},
+	}
+	hash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		t.Fatalf("TypedDataAndHash: %v", err)
+	}
+	return common.BytesToHash(hash)
+}
+
+func TestBuildLimitOrderAmounts(t *testing.T) {
+	tests := []struct {
+		side      models.OrderSide
+		price     string
+		size      string
+		wantMaker string
+		wantTaker string
+		wantSide  string
+	}{
+		{models.OrderSideBuy, "0.56", "21.04", "11782400", "21040000", "0"},
+		{models.OrderSideSell, "0.56", "21.04", "21040000", "11782400", "1"},
+		{models.OrderSideBuy, "0.82", "101", "82820000", "101000000", "0"},
+		{models.OrderSideSell, "0.82", "101", "101000000", "82820000", "1"},
+	}
+	b := newTestBuilder(t)
+	for _, tt := range tests {
+		order, err := b.BuildLimitOrder(limitArgs(tt.side, tt.price, tt.size, false))
+		if err != nil {
+			t.Fatalf("BuildLimitOrder: %v", err)
+		}
+		signed := order.SignedOrder
+		if signed.MakerAmount != tt.wantMaker || signed.TakerAmount != tt.wantTaker || signed.Side != tt.wantSide {
+			t.Errorf("side %d %sx%s: maker, taker, side = %s, %s, %s, want %s, %s, %s", tt.side, tt.price, tt.size,
+				signed.MakerAmount, signed.TakerAmount, signed.Side, tt.wantMaker, tt.wantTaker, tt.wantSide)
+		}
+		if _, err := VerifyOrder(auth.PolygonChainID, signed, false); err != nil {
+			t.Errorf("VerifyOrder: %v", err)
+		}
+	}
+}
+
+func TestBuildMarketOrderAmounts(t *testing.T) {
+	tests := []struct {
+		side      models.OrderSide
+		amount    string
+		price     string
+		wantMaker string
+		wantTaker string
+	}{
+		{models.OrderSideBuy, "100", "0.56", "100000000", "178571400"},
+		{models.OrderSideSell, "21.04", "0.56", "21040000", "11782400"},
+	}
+	b := newTestBuilder(t)
+	for _, tt := range tests {
+		order, err := b.BuildMarketOrder(&MarketOrderArgs{
+			OrderArgs: OrderArgs{TokenID: testTokenID, Side: tt.side, Market: MarketParams{TickSize: "0.01"}},
+			Amount:    models.MustDecimal(tt.amount),
+			Price:     models.MustDecimal(tt.price),
+		})
+		if err != nil {
+			t.Fatalf("BuildMarketOrder: %v", err)
+		}
+		if order.SignedOrder.MakerAmount != tt.wantMaker || order.SignedOrder.TakerAmount != tt.wantTaker {
+			t.Errorf("side %d: maker, taker = %s, %s, want %s, %s", tt.side,
+				order.SignedOrder.MakerAmount, order.SignedOrder.TakerAmount, tt.wantMaker, tt.wantTaker)
+		}
+	}
+}
+
+func TestBuildLimitOrderRejectsInvalidInputs(t *testing.T) {
+	tests := []struct {
+		name  string
+		price string
+		size  string
+	}{
+		{"zero price", "0", "10"},
+		{"negative price", "-0.5", "10"},
+		{"price rounding to zero", "0.004", "10"},
+		{"price of one", "1", "10"},
+		{"price above one", "1.5", "10"},
+		{"price rounding to one", "0.996", "10"},
+		{"zero size", "0.5", "0"},
+		{"negative size", "0.5", "-10"},
+		{"size rounding to zero", "0.5", "0.009"},
+	}
+	b := newTestBuilder(t)
+	for _, tt := range tests {
+		order, err := b.BuildLimitOrder(limitArgs(models.OrderSideBuy, tt.price, tt.size, false))
+		if err == nil {
+			t.Errorf("%s: signed an order with maker amount %s, want an error", tt.name, order.SignedOrder.MakerAmount)
+		} else if !strings.Contains(err.Error(), "limit order") {
+			t.Errorf("%s: unexpected error %v", tt.name, err)
+		}
+	}
+}
+
+func TestBuildMarketOrderRejectsInvalidInputs(t *testing.T) {
+	tests := []struct {
+		name   string
+		side   models.OrderSide
+		amount string
+		price  string
+	}{
+		{"zero amount", models.OrderSideBuy, "0", "0.5"},
+		{"negative amount", models.OrderSideSell, "-10", "0.5"},
+		{"amount rounding to zero", models.OrderSideSell, "0.009", "0.5"},
+		{"zero price", models.OrderSideBuy, "10", "0"},
+		{"negative price", models.OrderSideSell, "10", "-0.5"},
+		{"price rounding to zero", models.OrderSideBuy, "10", "0.009"},
+		{"price of one", models.OrderSideBuy, "10", "1"},
+		{"price above one", models.OrderSideBuy, "10", "1.5"},
+		{"price of seven", models.OrderSideSell, "10", "7"},
+	}
+	b := newTestBuilder(t)
+	for _, tt := range tests {
+		order, err := b.BuildMarketOrder(&MarketOrderArgs{
+			OrderArgs: OrderArgs{TokenID: testTokenID, Side: tt.side, Market: MarketParams{TickSize: "0.01"}},
+			Amount:    models.MustDecimal(tt.amount),
+			Price:     models.MustDecimal(tt.price),
+		})
+		if err == nil {
+			t.Errorf("%s: signed an order with maker amount %s, want an error", tt.name, order.SignedOrder.MakerAmount)
+		} else if !strings.Contains(err.Error(), "market order") {
+			t.Errorf("%s: unexpected error %v", tt.name, err)
+		}
+	}
+}
+
+func TestBuilderWithContracts(t *testing.T) {
+	signer, err := auth.NewPrivateKeySigner(testPrivateKey)
+	if err != nil {
+		t.Fatalf("NewPrivateKeySigner: %v", err)
+	}
+	custom := &orderconfig.Contracts{
+		Exchange:        common.HexToAddress("0x00000000000000000000000000000000000000e1"),
+		NegRiskExchange: common.HexToAddress("0x00000000000000000000000000000000000000e2"),
+	}
+	b, err := NewBuilderWithContracts(auth.PolygonChainID, custom, signer, models.SignatureTypeEOA, "")
+	if err != nil {
+		t.Fatalf("NewBuilderWithContracts: %v", err)
+	}
+	b.saltGenerator = func() int64 { return testSalt }
+	polygon := newTestBuilder(t)
+
+	for _, negRisk := range []bool{false, true} {
+		order, err := b.BuildLimitOrder(limitArgs(models.OrderSideBuy, "0.56", "21.04", negRisk))
+		if err != nil {
+			t.Fatalf("BuildLimitOrder: %v", err)
+		}
+		if _, err := VerifyOrderWithContracts(auth.PolygonChainID, custom, order.SignedOrder, negRisk); err != nil {
+			t.Errorf("neg risk %v: VerifyOrderWithContracts: %v", negRisk, err)
+		}
+		if hash, _ := OrderHashWithContracts(auth.PolygonChainID, custom, order.SignedOrder, negRisk); hash != order.Hash {
+			t.Errorf("neg risk %v: OrderHashWithContracts = %s, want %s", negRisk, hash.Hex(), order.Hash.Hex())
+		}
+
+		// The same order signed for the Polygon exchanges has another hash
+		polygonOrder, _ := polygon.BuildLimitOrder(limitArgs(models.OrderSideBuy, "0.56", "21.04", negRisk))
+		if polygonOrder.Hash == order.Hash {
+			t.Errorf("neg risk %v: hash %s does not depend on the exchange", negRisk, order.Hash.Hex())
+		}
+		_, err = VerifyOrder(auth.PolygonChainID, order.SignedOrder, negRisk)
+		expectReason(t, "custom exchange order verified for Polygon", err, "not by the signer")
+	}
+
+	if _, err := NewBuilder(1, signer, models.SignatureTypeEOA, ""); err == nil {
+		t.Error("NewBuilder on a chain without contracts expected an error")
+	}
+	if _, err := NewBuilderWithContracts(auth.PolygonChainID, nil, signer, models.SignatureTypeEOA, ""); err == nil {
+		t.Error("NewBuilderWithContracts without contracts expected an error")
+	}
+}
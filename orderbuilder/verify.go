@@ -0,0 +1,319 @@
+package orderbuilder
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/mtt-labs/poly-market-sdk/auth"
+	"github.com/mtt-labs/poly-market-sdk/models"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	orderconfig "github.com/polymarket/go-order-utils/pkg/config"
+	"github.com/polymarket/go-order-utils/pkg/eip712"
+	ordermodel "github.com/polymarket/go-order-utils/pkg/model"
+)
+
+// EIP-712 domain and Order type of the CTF exchanges, as in go-order-utils
+// Reference: https://github.com/Polymarket/ctf-exchange/blob/main/src/exchange/libraries/OrderStructs.sol
+var (
+	protocolName    = crypto.Keccak256Hash([]byte("Polymarket CTF Exchange"))
+	protocolVersion = crypto.Keccak256Hash([]byte("1"))
+	orderTypeHash   = crypto.Keccak256Hash([]byte("Order(uint256 salt,address maker,address signer,address taker,uint256 tokenId,uint256 makerAmount,uint256 takerAmount,uint256 expiration,uint256 nonce,uint256 feeRateBps,uint8 side,uint8 signatureType)"))
+	orderStructure  = []abi.Type{
+		eip712.Bytes32, // typehash
+		eip712.Uint256, // salt
+		eip712.Address, // maker
+		eip712.Address, // signer
+		eip712.Address, // taker
+		eip712.Uint256, // tokenId
+		eip712.Uint256, // makerAmount
+		eip712.Uint256, // takerAmount
+		eip712.Uint256, // expiration
+		eip712.Uint256, // nonce
+		eip712.Uint256, // feeRateBps
+		eip712.Uint8,   // side
+		eip712.Uint8,   // signatureType
+	}
+)
+
+// VerificationError is returned by VerifyOrder and lists every inconsistency found in a signed order
+type VerificationError struct {
+	Reasons []string // One reason per mismatch or invalid field
+}
+
+// Error implements the error interface
+func (e *VerificationError) Error() string {
+	return "invalid signed order: " + strings.Join(e.Reasons, "; ")
+}
+
+// OrderHash recomputes the EIP-712 hash of a signed order for the CTF Exchange of a chain,
+// or for the NegRisk CTF Exchange when negRisk is set
+func OrderHash(chainID int, order *models.SignedOrder, negRisk bool) (common.Hash, error) {
+	contracts, err := chainContracts(chainID)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return OrderHashWithContracts(chainID, contracts, order, negRisk)
+}
+
+// OrderHashWithContracts is like OrderHash but for the exchanges of contracts (client.Network().Contracts)
+func OrderHashWithContracts(chainID int, contracts *orderconfig.Contracts, order *models.SignedOrder, negRisk bool) (common.Hash, error) {
+	if contracts == nil {
+		return common.Hash{}, fmt.Errorf("contracts are required")
+	}
+	parsed, reasons := parseOrder(order)
+	if len(reasons) > 0 {
+		return common.Hash{}, &VerificationError{Reasons: reasons}
+	}
+	return orderHash(chainID, contracts, parsed, negRisk)
+}
+
+// RecoverSigner recovers the address that signed an order hash from a hex signature
+// The signature is 65 bytes (r, s, v) with v in {27, 28} or {0, 1}
+func RecoverSigner(orderHash common.Hash, signature string) (common.Address, error) {
+	sig, err := decodeSignature(signature)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if sig[64] >= 27 {
+		sig[64] -= 27
+	}
+
+	publicKey, err := crypto.SigToPub(orderHash.Bytes(), sig)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("recover signer: %w", err)
+	}
+	return crypto.PubkeyToAddress(*publicKey), nil
+}
+
+// VerifyOrder checks a signed order before it is forwarded: the hash is recomputed for the exchange
+// (negRisk selects the NegRisk CTF Exchange), the signer is recovered from the signature and the maker,
+// signer and signature type must be consistent. On Polygon the maker of a proxy wallet or Safe order must be
+// the wallet derived from the signer. It returns the order hash, and a *VerificationError listing every mismatch.
+func VerifyOrder(chainID int, order *models.SignedOrder, negRisk bool) (common.Hash, error) {
+	contracts, err := chainContracts(chainID)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return VerifyOrderWithContracts(chainID, contracts, order, negRisk)
+}
+
+// VerifyOrderWithContracts is like VerifyOrder but for the exchanges of contracts (client.Network().Contracts)
+func VerifyOrderWithContracts(chainID int, contracts *orderconfig.Contracts, order *models.SignedOrder, negRisk bool) (common.Hash, error) {
+	if contracts == nil {
+		return common.Hash{}, fmt.Errorf("contracts are required")
+	}
+	parsed, reasons := parseOrder(order)
+	if parsed == nil {
+		return common.Hash{}, &VerificationError{Reasons: reasons}
+	}
+
+	hash := common.Hash{}
+	if len(reasons) == 0 {
+		var err error
+		hash, err = orderHash(chainID, contracts, parsed, negRisk)
+		if err != nil {
+			return common.Hash{}, err
+		}
+		reasons = append(reasons, signatureReasons(chainID, contracts, order, parsed, hash, negRisk)...)
+	} else if _, err := decodeSignature(order.Signature); err != nil {
+		// The hash cannot be computed from invalid fields, a malformed signature is still reported
+		reasons = append(reasons, err.Error())
+	}
+	reasons = append(reasons, makerReasons(chainID, parsed)...)
+
+	if len(reasons) > 0 {
+		return hash, &VerificationError{Reasons: reasons}
+	}
+	return hash, nil
+}
+
+// signatureReasons checks that the signature of an order was made by its signer for the exchange
+func signatureReasons(chainID int, contracts *orderconfig.Contracts, order *models.SignedOrder, parsed *ordermodel.Order, hash common.Hash, negRisk bool) []string {
+	recovered, err := RecoverSigner(hash, order.Signature)
+	if err != nil {
+		return []string{err.Error()}
+	}
+	if recovered == parsed.Signer {
+		return nil
+	}
+
+	// A signature made for the other exchange domain means the order was built with the wrong neg-risk flag
+	if otherHash, err := orderHash(chainID, contracts, parsed, !negRisk); err == nil {
+		if other, err := RecoverSigner(otherHash, order.Signature); err == nil && other == parsed.Signer {
+			return []string{fmt.Sprintf("signature is for the %s domain, not the %s", exchangeName(!negRisk), exchangeName(negRisk))}
+		}
+	}
+	return []string{fmt.Sprintf("signature was made by %s, not by the signer %s (or the order was modified after signing)", recovered.Hex(), parsed.Signer.Hex())}
+}
+
+// makerReasons checks that the maker of an order matches its signer and signature type
+func makerReasons(chainID int, parsed *ordermodel.Order) []string {
+	maker, signer := parsed.Maker, parsed.Signer
+	signatureType := models.SignatureType(parsed.SignatureType.Int64())
+
+	// Wallets can only be derived where the factories are known (Polygon)
+	factories, _ := auth.WalletFactoriesForChain(chainID)
+	expected, known, err := auth.ExpectedMaker(factories, int(signatureType), signer)
+	if err != nil {
+		// Unsupported signature types are reported by parseOrder
+		return nil
+	}
+
+	if signatureType == models.SignatureTypeEOA {
+		if maker != signer {
+			return []string{fmt.Sprintf("maker %s differs from signer %s for signature type EOA (0)%s",
+				maker.Hex(), signer.Hex(), walletHint(factories, signer, maker))}
+		}
+		return nil
+	}
+
+	name := "proxy wallet"
+	if signatureType == models.SignatureTypeBrowserWallet {
+		name = "Gnosis Safe"
+	}
+	if maker == signer {
+		return []string{fmt.Sprintf("maker equals signer %s, signature type %d requires the %s of the signer as maker",
+			signer.Hex(), signatureType, name)}
+	}
+	if known && maker != expected {
+		return []string{fmt.Sprintf("maker %s is not the %s %s of signer %s for signature type %d%s",
+			maker.Hex(), name, expected.Hex(), signer.Hex(), signatureType, walletHint(factories, signer, maker))}
+	}
+	return nil
+}
+
+// walletHint suggests the signature type matching a maker derived from the signer
+func walletHint(factories *auth.WalletFactories, signer, maker common.Address) string {
+	signatureType, ok := auth.MakerSignatureType(factories, signer, maker)
+	if !ok {
+		return ""
+	}
+	switch models.SignatureType(signatureType) {
+	case models.SignatureTypeEOA:
+		return ", the maker is the signer, signature type EOA (0) expected"
+	case models.SignatureTypeEmailMagic:
+		return ", the maker is the proxy wallet of the signer, signature type 1 expected"
+	default:
+		return ", the maker is the Gnosis Safe of the signer, signature type 2 expected"
+	}
+}
+
+// parseOrder converts a signed order to a go-order-utils order, collecting a reason per invalid field
+// The order is nil when it is missing
+func parseOrder(order *models.SignedOrder) (*ordermodel.Order, []string) {
+	if order == nil {
+		return nil, []string{"signed order is required"}
+	}
+
+	var reasons []string
+	address := func(field, value string) common.Address {
+		if !common.IsHexAddress(value) {
+			reasons = append(reasons, fmt.Sprintf("%s: invalid address %q", field, value))
+		}
+		return common.HexToAddress(value)
+	}
+	uint256Int := func(field string, n *big.Int) *big.Int {
+		if n == nil || n.Sign() < 0 || n.BitLen() > 256 {
+			reasons = append(reasons, fmt.Sprintf("%s: invalid uint256 %v", field, n))
+			return new(big.Int)
+		}
+		return n
+	}
+	uint256 := func(field, value string) *big.Int {
+		n, ok := new(big.Int).SetString(value, 10)
+		if !ok || n.Sign() < 0 || n.BitLen() > 256 {
+			reasons = append(reasons, fmt.Sprintf("%s: invalid uint256 %q", field, value))
+			return new(big.Int)
+		}
+		return n
+	}
+
+	parsed := &ordermodel.Order{
+		Salt:          uint256Int("salt", order.Salt),
+		Maker:         address("maker", order.Maker),
+		Signer:        address("signer", order.Signer),
+		Taker:         address("taker", order.Taker),
+		TokenId:       uint256("tokenId", order.TokenID),
+		MakerAmount:   uint256("makerAmount", order.MakerAmount),
+		TakerAmount:   uint256("takerAmount", order.TakerAmount),
+		Expiration:    uint256("expiration", order.Expiration),
+		Nonce:         uint256("nonce", order.Nonce),
+		FeeRateBps:    uint256("feeRateBps", order.FeeRateBps),
+		Side:          uint256("side", order.Side),
+		SignatureType: big.NewInt(int64(order.SignatureType)),
+	}
+	if parsed.Side.Cmp(big.NewInt(int64(models.OrderSideSell))) > 0 {
+		reasons = append(reasons, fmt.Sprintf("side: invalid side %s, expected 0 (BUY) or 1 (SELL)", order.Side))
+	}
+	switch models.SignatureType(order.SignatureType) {
+	case models.SignatureTypeEOA, models.SignatureTypeEmailMagic, models.SignatureTypeBrowserWallet:
+	default:
+		reasons = append(reasons, fmt.Sprintf("signatureType: unsupported signature type %d", order.SignatureType))
+	}
+	return parsed, reasons
+}
+
+// decodeSignature decodes a hex-encoded 65 bytes signature
+func decodeSignature(signature string) ([]byte, error) {
+	sig, err := hexutil.Decode(signature)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signature %q: %w", signature, err)
+	}
+	if len(sig) != crypto.SignatureLength {
+		return nil, fmt.Errorf("invalid signature: %d bytes, expected %d", len(sig), crypto.SignatureLength)
+	}
+	return sig, nil
+}
+
+// chainContracts returns the contracts of a chain supported by go-order-utils
+func chainContracts(chainID int) (*orderconfig.Contracts, error) {
+	contracts, err := orderconfig.GetContracts(int64(chainID))
+	if err != nil {
+		return nil, fmt.Errorf("contracts of chain %d: %w", chainID, err)
+	}
+	return contracts, nil
+}
+
+// orderHash computes the EIP-712 hash of an order for the domain of an exchange of contracts
+func orderHash(chainID int, contracts *orderconfig.Contracts, order *ordermodel.Order, negRisk bool) (common.Hash, error) {
+	exchange := contracts.Exchange
+	if negRisk {
+		exchange = contracts.NegRiskExchange
+	}
+	domainSeparator, err := eip712.BuildEIP712DomainSeparator(protocolName, protocolVersion, big.NewInt(int64(chainID)), exchange)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("build order hash: %w", err)
+	}
+	hash, err := eip712.HashTypedDataV4(domainSeparator, orderStructure, []any{
+		orderTypeHash,
+		order.Salt,
+		order.Maker,
+		order.Signer,
+		order.Taker,
+		order.TokenId,
+		order.MakerAmount,
+		order.TakerAmount,
+		order.Expiration,
+		order.Nonce,
+		order.FeeRateBps,
+		uint8(order.Side.Uint64()),
+		uint8(order.SignatureType.Uint64()),
+	})
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("build order hash: %w", err)
+	}
+	return hash, nil
+}
+
+// exchangeName returns the name of the exchange of an order
+func exchangeName(negRisk bool) string {
+	if negRisk {
+		return "NegRisk CTF Exchange"
+	}
+	return "CTF Exchange"
+}
@@ -0,0 +1,209 @@
+package orderbuilder
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/mtt-labs/poly-market-sdk/auth"
+	"github.com/mtt-labs/poly-market-sdk/models"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// buildOrder builds a signed limit order of the test key for a signature type and funder
+func buildOrder(t *testing.T, chainID int, signatureType models.SignatureType, funder string, negRisk bool) *models.SignedOrder {
+	t.Helper()
+	signer, err := auth.NewPrivateKeySigner(testPrivateKey)
+	if err != nil {
+		t.Fatalf("NewPrivateKeySigner: %v", err)
+	}
+	b, err := NewBuilder(chainID, signer, signatureType, funder)
+	if err != nil {
+		t.Fatalf("NewBuilder: %v", err)
+	}
+	order, err := b.BuildLimitOrder(limitArgs(models.OrderSideBuy, "0.56", "21.04", negRisk))
+	if err != nil {
+		t.Fatalf("BuildLimitOrder: %v", err)
+	}
+	return order.SignedOrder
+}
+
+// walletsOf returns the proxy wallet and Safe of the test key on Polygon
+func walletsOf(t *testing.T) (proxy, safe string) {
+	t.Helper()
+	owner, err := auth.GetAddressFromPrivateKey(testPrivateKey)
+	if err != nil {
+		t.Fatalf("GetAddressFromPrivateKey: %v", err)
+	}
+	proxy, _ = auth.ProxyWalletAddress(auth.PolygonChainID, owner)
+	safe, _ = auth.SafeAddress(auth.PolygonChainID, owner)
+	return proxy, safe
+}
+
+// expectReason checks that VerifyOrder fails with a reason containing want
+func expectReason(t *testing.T, name string, err error, want string) {
+	t.Helper()
+	var verr *VerificationError
+	if !errors.As(err, &verr) {
+		t.Errorf("%s: error = %v, want a *VerificationError", name, err)
+		return
+	}
+	for _, reason := range verr.Reasons {
+		if strings.Contains(reason, want) {
+			return
+		}
+	}
+	t.Errorf("%s: reasons %q do not mention %q", name, verr.Reasons, want)
+}
+
+func TestVerifyOrderValid(t *testing.T) {
+	proxy, safe := walletsOf(t)
+	tests := []struct {
+		name          string
+		signatureType models.SignatureType
+		funder        string
+		negRisk       bool
+	}{
+		{"EOA", models.SignatureTypeEOA, "", false},
+		{"EOA neg risk", models.SignatureTypeEOA, "", true},
+		{"proxy wallet", models.SignatureTypeEmailMagic, proxy, false},
+		{"Gnosis Safe", models.SignatureTypeBrowserWallet, safe, true},
+	}
+	for _, tt := range tests {
+		order := buildOrder(t, auth.PolygonChainID, tt.signatureType, tt.funder, tt.negRisk)
+		hash, err := VerifyOrder(auth.PolygonChainID, order, tt.negRisk)
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if want, _ := OrderHash(auth.PolygonChainID, order, tt.negRisk); hash != want {
+			t.Errorf("%s: hash = %s, want %s", tt.name, hash.Hex(), want.Hex())
+		}
+	}
+}
+
+func TestVerifyOrderWrongDomain(t *testing.T) {
+	order := buildOrder(t, auth.PolygonChainID, models.SignatureTypeEOA, "", true)
+	_, err := VerifyOrder(auth.PolygonChainID, order, false)
+	expectReason(t, "neg risk order verified for the CTF Exchange", err, "signature is for the NegRisk CTF Exchange domain")
+
+	order = buildOrder(t, auth.PolygonChainID, models.SignatureTypeEOA, "", false)
+	_, err = VerifyOrder(auth.PolygonChainID, order, true)
+	expectReason(t, "CTF Exchange order verified for the neg risk exchange", err, "signature is for the CTF Exchange domain")
+
+	order = buildOrder(t, auth.AmoyChainID, models.SignatureTypeEOA, "", false)
+	_, err = VerifyOrder(auth.PolygonChainID, order, false)
+	expectReason(t, "Amoy order verified for Polygon", err, "not by the signer")
+}
+
+func TestVerifyOrderTamperedAmount(t *testing.T) {
+	order := buildOrder(t, auth.PolygonChainID, models.SignatureTypeEOA, "", false)
+	order.MakerAmount = "11782401"
+	_, err := VerifyOrder(auth.PolygonChainID, order, false)
+	expectReason(t, "tampered maker amount", err, "or the order was modified after signing")
+
+	order = buildOrder(t, auth.PolygonChainID, models.SignatureTypeEOA, "", false)
+	order.TakerAmount = "not-a-number"
+	_, err = VerifyOrder(auth.PolygonChainID, order, false)
+	expectReason(t, "invalid taker amount", err, "takerAmount: invalid uint256")
+}
+
+func TestVerifyOrderWrongMaker(t *testing.T) {
+	proxy, safe := walletsOf(t)
+	signer, _ := auth.GetAddressFromPrivateKey(testPrivateKey)
+	other := "0x70997970C51812dc3A010C7d01b50e0d17dc79C8"
+
+	tests := []struct {
+		name          string
+		signatureType models.SignatureType
+		funder        string
+		want          string
+	}{
+		{"proxy type with the Safe", models.SignatureTypeEmailMagic, safe, "signature type 2 expected"},
+		{"proxy type with another address", models.SignatureTypeEmailMagic, other, "is not the proxy wallet " + proxy},
+		{"proxy type with the signer", models.SignatureTypeEmailMagic, signer, "requires the proxy wallet of the signer"},
+		{"Safe type with the proxy wallet", models.SignatureTypeBrowserWallet, proxy, "signature type 1 expected"},
+		{"Safe type with another address", models.SignatureTypeBrowserWallet, other, "is not the Gnosis Safe " + safe},
+		{"Safe type with the signer", models.SignatureTypeBrowserWallet, signer, "requires the Gnosis Safe of the signer"},
+		{"EOA type with the proxy wallet", models.SignatureTypeEOA, proxy, "signature type 1 expected"},
+	}
+	for _, tt := range tests {
+		order := buildOrder(t, auth.PolygonChainID, tt.signatureType, tt.funder, false)
+		_, err := VerifyOrder(auth.PolygonChainID, order, false)
+		expectReason(t, tt.name, err, tt.want)
+	}
+}
+
+func TestVerifyOrderUnknownWalletFactories(t *testing.T) {
+	// Wallets cannot be derived on Amoy, any maker other than the signer is accepted for types 1 and 2
+	order := buildOrder(t, auth.AmoyChainID, models.SignatureTypeEmailMagic, "0x70997970C51812dc3A010C7d01b50e0d17dc79C8", false)
+	if _, err := VerifyOrder(auth.AmoyChainID, order, false); err != nil {
+		t.Errorf("VerifyOrder: %v", err)
+	}
+}
+
+func TestSignedOrderLargeSaltRoundTrip(t *testing.T) {
+	signer, err := auth.NewPrivateKeySigner(testPrivateKey)
+	if err != nil {
+		t.Fatalf("NewPrivateKeySigner: %v", err)
+	}
+
+	// Salts generated by other clients can use the whole uint256 range, 2^64 + 12345 does not fit an int64
+	const saltText = "18446744073709563961"
+	salt, _ := new(big.Int).SetString(saltText, 10)
+	order := buildOrder(t, auth.PolygonChainID, models.SignatureTypeEOA, "", false)
+	order.Salt = salt
+	hash, err := OrderHash(auth.PolygonChainID, order, false)
+	if err != nil {
+		t.Fatalf("OrderHash: %v", err)
+	}
+	signature, err := signer.SignOrderHash(hash)
+	if err != nil {
+		t.Fatalf("SignOrderHash: %v", err)
+	}
+	order.Signature = hexutil.Encode(signature)
+
+	data, err := json.Marshal(&models.CreateOrderRequest{Order: order, Owner: "owner-key", OrderType: models.OrderTypeGTC})
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+
+	// The CLOB expects the salt as a JSON number with every digit
+	var raw struct {
+		Order map[string]json.RawMessage `json:"order"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		t.Fatalf("unmarshal raw: %v", err)
+	}
+	if got := string(raw.Order["salt"]); got != saltText {
+		t.Errorf("raw salt = %s, want %s", got, saltText)
+	}
+
+	var decoded models.CreateOrderRequest
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if decoded.Order.Salt.Cmp(salt) != 0 {
+		t.Errorf("decoded salt = %s, want %s", decoded.Order.Salt, salt)
+	}
+	decodedHash, err := OrderHash(auth.PolygonChainID, decoded.Order, false)
+	if err != nil {
+		t.Fatalf("OrderHash of the decoded order: %v", err)
+	}
+	if decodedHash != hash {
+		t.Errorf("decoded order hash = %s, want %s", decodedHash.Hex(), hash.Hex())
+	}
+	recovered, err := RecoverSigner(decodedHash, decoded.Order.Signature)
+	if err != nil {
+		t.Fatalf("RecoverSigner: %v", err)
+	}
+	if recovered.Hex() != signer.Address() {
+		t.Errorf("recovered signer = %s, want %s", recovered.Hex(), signer.Address())
+	}
+	if _, err := VerifyOrder(auth.PolygonChainID, decoded.Order, false); err != nil {
+		t.Errorf("VerifyOrder of the decoded order: %v", err)
+	}
+}
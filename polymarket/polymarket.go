@@ -1,20 +1,20 @@
 package polymarket
 
 import (
-	"fmt"
-
 	"github.com/mtt-labs/poly-market-sdk/api"
 	"github.com/mtt-labs/poly-market-sdk/client"
 )
 
 // Polymarket is the main entry point of the SDK
 type Polymarket struct {
-	Client  *client.Client
-	Markets *api.MarketsAPI
-	Orders  *api.OrdersAPI
-	Auth    *api.AuthAPI
-	Events  *api.EventsAPI
-	Search  *api.SearchAPI
+	Client     *client.Client
+	Markets    *api.MarketsAPI
+	MarketData *api.MarketDataAPI
+	Orders     *api.OrdersAPI
+	Nonces     *api.NonceManager // On-chain exchange nonces, use Orders.SetNonceManager(Nonces) to sign orders with them
+	Auth       *api.AuthAPI
+	Events     *api.EventsAPI
+	Search     *api.SearchAPI
 }
 
 // New creates a new Polymarket SDK instance
@@ -25,19 +25,37 @@ func New(config *client.Config) (*Polymarket, error) {
 		return nil, err
 	}
 
-	return &Polymarket{
-		Client:  c,
-		Markets: api.NewMarketsAPI(c),
-		Orders:  api.NewOrdersAPI(c),
-		Auth:    api.NewAuthAPI(c),
-		Events:  api.NewEventsAPI(c),
-		Search:  api.NewSearchAPI(c),
-	}, nil
+	return newPolymarket(c), nil
+}
+
+// NewPublic creates a read-only SDK instance that holds no private key
+// Markets, MarketData, Events and Search work as usual; Orders and Auth return client.ErrNoSigner
+// config may be nil to use defaults
+func NewPublic(config *client.Config) (*Polymarket, error) {
+	c, err := client.NewPublicClient(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return newPolymarket(c), nil
 }
 
-// NewWithDefaults creates SDK instance with default config (only for APIs that don't require authentication)
-// Note: Most operations require a private key, please use New() with a Config
+// NewWithDefaults creates a read-only SDK instance with default config (only for APIs that don't require authentication)
+// Note: Trading requires a private key, please use New() with a Config
 func NewWithDefaults() (*Polymarket, error) {
-	// Return error because private key is required
-	return nil, fmt.Errorf("private key is required, please use New() with a Config")
+	return NewPublic(nil)
+}
+
+// newPolymarket wires every API to the client
+func newPolymarket(c *client.Client) *Polymarket {
+	return &Polymarket{
+		Client:     c,
+		Markets:    api.NewMarketsAPI(c),
+		MarketData: api.NewMarketDataAPI(c),
+		Orders:     api.NewOrdersAPI(c),
+		Nonces:     api.NewNonceManager(c),
+		Auth:       api.NewAuthAPI(c),
+		Events:     api.NewEventsAPI(c),
+		Search:     api.NewSearchAPI(c),
+	}
 }